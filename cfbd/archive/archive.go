@@ -0,0 +1,130 @@
+// Package archive reads and writes bulk dumps of CFBD response messages as
+// newline-delimited protojson or varint length-delimited binary protobuf
+// streams. Every archive starts with a small header naming the message type
+// and the cfbd.proto schema it was written with, and archives are read back
+// lazily one message at a time.
+package archive
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Format is the encoding of an archive.
+type Format string
+
+const (
+	// FormatNDJSON writes one protojson message per line.
+	FormatNDJSON Format = "cfbd-ndjson"
+	// FormatDelimited writes varint length-prefixed binary messages.
+	FormatDelimited Format = "cfbd-delimited"
+)
+
+// delimitedMagic opens every FormatDelimited archive so readers can tell
+// the two formats apart.
+var delimitedMagic = []byte("CFBDPB\x00\x01")
+
+var (
+	// ErrUnknownFormat is returned when an archive does not start with a
+	// recognized header.
+	ErrUnknownFormat = errors.New("unknown archive format")
+	// ErrMessageTypeMismatch is returned when an archive holds a different
+	// message type than the reader expects.
+	ErrMessageTypeMismatch = errors.New("archive message type mismatch")
+	// ErrSchemaMismatch is returned when an archive was written with a
+	// different cfbd.proto package version.
+	ErrSchemaMismatch = errors.New("archive schema mismatch")
+)
+
+// Header describes the contents of an archive.
+type Header struct {
+	// Format is the archive encoding.
+	Format Format `json:"format"`
+	// MessageType is the full protobuf name, e.g. "cfbd.v1.Game".
+	MessageType string `json:"messageType"`
+	// Schema is the cfbd.proto package, e.g. "cfbd.v1". Readers reject
+	// archives written with a different package version.
+	Schema string `json:"schema"`
+	// SchemaDigest identifies the exact cfbd.proto revision. Field
+	// additions change the digest without breaking compatibility, so
+	// readers expose it rather than enforce it.
+	SchemaDigest string `json:"schemaDigest"`
+}
+
+var schemaDigest = digest(cfbd.File_cfbd_internal_proto_cfbd_proto)
+
+// SchemaDigest returns the digest of the compiled-in cfbd.proto, as written
+// to Header.SchemaDigest.
+func SchemaDigest() string {
+	return schemaDigest
+}
+
+func digest(fd protoreflect.FileDescriptor) string {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(
+		protodesc.ToFileDescriptorProto(fd),
+	)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:8])
+}
+
+func newHeader(format Format, md protoreflect.MessageDescriptor) Header {
+	return Header{
+		Format:       format,
+		MessageType:  string(md.FullName()),
+		Schema:       string(md.ParentFile().Package()),
+		SchemaDigest: schemaDigest,
+	}
+}
+
+// validate checks that an archive header can be decoded into messages
+// described by md.
+func (h Header) validate(md protoreflect.MessageDescriptor) error {
+	if h.Format != FormatNDJSON && h.Format != FormatDelimited {
+		return fmt.Errorf("format %q; %w", h.Format, ErrUnknownFormat)
+	}
+	if h.MessageType != string(md.FullName()) {
+		return fmt.Errorf("archive holds %s, reader expects %s; %w",
+			h.MessageType, md.FullName(), ErrMessageTypeMismatch)
+	}
+	if pkg := string(md.ParentFile().Package()); h.Schema != pkg {
+		return fmt.Errorf("archive schema %s, reader schema %s; %w",
+			h.Schema, pkg, ErrSchemaMismatch)
+	}
+
+	return nil
+}
+
+// messageType resolves the type T decodes into. When T is an interface such
+// as proto.Message, the type named by the header is looked up instead.
+func messageType[T proto.Message](h *Header) (protoreflect.MessageType, error) {
+	var zero T
+	if any(zero) != nil {
+		return zero.ProtoReflect().Type(), nil
+	}
+	if h == nil {
+		return nil, fmt.Errorf(
+			"cannot write %T without a concrete message type", zero,
+		)
+	}
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(
+		protoreflect.FullName(h.MessageType),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve %s; %w", h.MessageType, err)
+	}
+
+	return mt, nil
+}
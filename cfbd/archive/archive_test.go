@@ -0,0 +1,187 @@
+package archive
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func loadGames(t *testing.T) []*cfbd.Game {
+	games := fixture.Load(t, "games.json",
+		func() *cfbd.Game { return &cfbd.Game{} })
+	// Repeat the fixture so the archive holds more than one record.
+	return append(games, games...)
+}
+
+func readAll[T proto.Message](t *testing.T, r io.Reader) (Header, []T) {
+	reader, err := NewReader[T](r)
+	require.NoError(t, err)
+
+	var out []T
+	for m, err := range reader.All() {
+		require.NoError(t, err)
+		out = append(out, m)
+	}
+	return reader.Header(), out
+}
+
+func TestArchive_RoundTrip_ShouldPreserveMessages(t *testing.T) {
+	games := loadGames(t)
+	for _, format := range []Format{FormatNDJSON, FormatDelimited} {
+		t.Run(string(format), func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, WriteAll(&buf, format, games))
+
+			header, got := readAll[*cfbd.Game](t, &buf)
+
+			assert.Equal(t, format, header.Format)
+			assert.Equal(t, "cfbd.v1.Game", header.MessageType)
+			assert.Equal(t, "cfbd.v1", header.Schema)
+			assert.Equal(t, SchemaDigest(), header.SchemaDigest)
+			require.Len(t, got, len(games))
+			for i := range games {
+				assert.True(t, proto.Equal(games[i], got[i]), "message %d", i)
+			}
+		})
+	}
+}
+
+func TestArchive_NDJSON_ShouldWriteHeaderAndOneMessagePerLine(t *testing.T) {
+	games := loadGames(t)
+	var buf bytes.Buffer
+
+	require.NoError(t, WriteAll(&buf, FormatNDJSON, games))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, len(games)+1)
+	assert.Contains(t, lines[0], `"messageType":"cfbd.v1.Game"`)
+	assert.True(t, strings.HasPrefix(lines[1], "{"))
+}
+
+func TestArchive_Delimited_ShouldBeSmallerThanNDJSON(t *testing.T) {
+	games := loadGames(t)
+	var ndjson, delimited bytes.Buffer
+
+	require.NoError(t, WriteAll(&ndjson, FormatNDJSON, games))
+	require.NoError(t, WriteAll(&delimited, FormatDelimited, games))
+
+	assert.Less(t, delimited.Len(), ndjson.Len())
+}
+
+func TestNewReader_AnyMessage_ShouldResolveTypeFromHeader(t *testing.T) {
+	var buf bytes.Buffer
+	play := &cfbd.Play{Id: "1", PlayText: "Kickoff"}
+	require.NoError(t, WriteAll(&buf, FormatDelimited, []*cfbd.Play{play}))
+
+	_, got := readAll[proto.Message](t, &buf)
+
+	require.Len(t, got, 1)
+	assert.True(t, proto.Equal(play, got[0]))
+}
+
+func TestNewReader_WrongType_ShouldFail(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteAll(&buf, FormatNDJSON, []*cfbd.Play{{Id: "1"}}))
+
+	_, err := NewReader[*cfbd.Game](&buf)
+
+	assert.ErrorIs(t, err, ErrMessageTypeMismatch)
+}
+
+func TestNewReader_OtherSchema_ShouldFail(t *testing.T) {
+	header := `{"format":"cfbd-ndjson","messageType":"cfbd.v1.Game",` +
+		`"schema":"cfbd.v2"}` + "\n"
+
+	_, err := NewReader[*cfbd.Game](strings.NewReader(header))
+
+	assert.ErrorIs(t, err, ErrSchemaMismatch)
+}
+
+func TestNewReader_NotAnArchive_ShouldFail(t *testing.T) {
+	_, err := NewReader[*cfbd.Game](strings.NewReader("id,season\n1,2025\n"))
+
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
+
+func TestNewWriter_InterfaceType_ShouldFail(t *testing.T) {
+	_, err := NewWriter[proto.Message](&bytes.Buffer{}, FormatNDJSON)
+
+	assert.Error(t, err)
+}
+
+func TestReader_TruncatedDelimited_ShouldFail(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteAll(&buf, FormatDelimited, loadGames(t)))
+	truncated := buf.Bytes()[:buf.Len()-3]
+
+	reader, err := NewReader[*cfbd.Game](bytes.NewReader(truncated))
+	require.NoError(t, err)
+
+	var lastErr error
+	for _, err := range reader.All() {
+		lastErr = err
+	}
+	require.Error(t, lastErr)
+	assert.False(t, errors.Is(lastErr, io.EOF))
+}
+
+func TestReader_Next_ShouldReadLazily(t *testing.T) {
+	games := loadGames(t)
+	var buf bytes.Buffer
+	require.NoError(t, WriteAll(&buf, FormatNDJSON, games))
+
+	reader, err := NewReader[*cfbd.Game](&buf)
+	require.NoError(t, err)
+
+	first, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, games[0].GetId(), first.GetId())
+	for range len(games) - 1 {
+		_, err = reader.Next()
+		require.NoError(t, err)
+	}
+	_, err = reader.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+// failingReader returns err once the bytes of r run out.
+type failingReader struct {
+	r   io.Reader
+	err error
+}
+
+func (f failingReader) Read(p []byte) (int, error) {
+	n, err := f.r.Read(p)
+	if errors.Is(err, io.EOF) {
+		return n, f.err
+	}
+	return n, err
+}
+
+func TestReader_FailureAtLineBoundary_ShouldNotLookLikeEOF(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteAll(&buf, FormatNDJSON, loadGames(t)))
+	b := buf.Bytes()
+	// Keep the header and the first message, ending on a newline.
+	headerEnd := bytes.IndexByte(b, '\n') + 1
+	cut := headerEnd + bytes.IndexByte(b[headerEnd:], '\n') + 1
+	boom := errors.New("boom")
+
+	reader, err := NewReader[*cfbd.Game](
+		failingReader{r: bytes.NewReader(b[:cut]), err: boom})
+	require.NoError(t, err)
+
+	_, err = reader.Next()
+	require.NoError(t, err)
+	_, err = reader.Next()
+	require.ErrorIs(t, err, boom)
+	assert.NotErrorIs(t, err, io.EOF)
+}
@@ -0,0 +1,165 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	maxHeaderSize  = 64 << 10
+	maxMessageSize = 64 << 20
+)
+
+// Reader lazily reads messages of type T from an archive.
+type Reader[T proto.Message] struct {
+	r      *bufio.Reader
+	header Header
+	mt     protoreflect.MessageType
+	json   protojson.UnmarshalOptions
+	binary protodelim.UnmarshalOptions
+}
+
+// NewReader reads and validates the archive header from r, detecting the
+// format. T is either the concrete message type held by the archive, such as
+// *cfbd.Game, or proto.Message to decode whatever type the header names.
+// Fields unknown to this version of cfbd.proto are discarded.
+func NewReader[T proto.Message](r io.Reader) (*Reader[T], error) {
+	br := bufio.NewReader(r)
+	header, err := readHeader(br)
+	if err != nil {
+		return nil, err
+	}
+
+	mt, err := messageType[T](&header)
+	if err != nil {
+		return nil, err
+	}
+	if err = header.validate(mt.Descriptor()); err != nil {
+		return nil, err
+	}
+
+	return &Reader[T]{
+		r:      br,
+		header: header,
+		mt:     mt,
+		json:   protojson.UnmarshalOptions{DiscardUnknown: true},
+		binary: protodelim.UnmarshalOptions{
+			UnmarshalOptions: proto.UnmarshalOptions{DiscardUnknown: true},
+			MaxSize:          maxMessageSize,
+		},
+	}, nil
+}
+
+func readHeader(r *bufio.Reader) (Header, error) {
+	var header Header
+	prefix, err := r.Peek(len(delimitedMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return header, fmt.Errorf("could not read archive header; %w", err)
+	}
+
+	var encoded []byte
+	detected := FormatNDJSON
+	if bytes.Equal(prefix, delimitedMagic) {
+		detected = FormatDelimited
+		_, _ = r.Discard(len(delimitedMagic))
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			return header, fmt.Errorf("could not read archive header; %w", err)
+		}
+		if size > maxHeaderSize {
+			return header, fmt.Errorf(
+				"header of %d bytes; %w", size, ErrUnknownFormat,
+			)
+		}
+		encoded = make([]byte, size)
+		if _, err = io.ReadFull(r, encoded); err != nil {
+			return header, fmt.Errorf("could not read archive header; %w", err)
+		}
+	} else {
+		encoded, err = r.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return header, fmt.Errorf("could not read archive header; %w", err)
+		}
+	}
+
+	if err = json.Unmarshal(encoded, &header); err != nil {
+		return header, fmt.Errorf("%w; %w", ErrUnknownFormat, err)
+	}
+	if header.Format != detected {
+		return header, fmt.Errorf("format %q; %w", header.Format, ErrUnknownFormat)
+	}
+
+	return header, nil
+}
+
+// Header returns the archive header.
+func (r *Reader[T]) Header() Header {
+	return r.header
+}
+
+// Next returns the next message, or io.EOF once the archive is exhausted.
+func (r *Reader[T]) Next() (T, error) {
+	var zero T
+	m, ok := r.mt.New().Interface().(T)
+	if !ok {
+		return zero, fmt.Errorf("archive holds %s, reader expects %T; %w",
+			r.header.MessageType, zero, ErrMessageTypeMismatch)
+	}
+
+	if r.header.Format == FormatDelimited {
+		if err := r.binary.UnmarshalFrom(r.r, m); err != nil {
+			if errors.Is(err, io.EOF) {
+				return zero, io.EOF
+			}
+			return zero, fmt.Errorf("could not read %s; %w",
+				r.header.MessageType, err)
+		}
+		return m, nil
+	}
+
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return zero, fmt.Errorf("could not read %s; %w",
+				r.header.MessageType, err)
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			if err != nil {
+				return zero, io.EOF
+			}
+			continue
+		}
+		if err = r.json.Unmarshal(line, m); err != nil {
+			return zero, fmt.Errorf("could not decode %s; %w",
+				r.header.MessageType, err)
+		}
+		return m, nil
+	}
+}
+
+// All iterates over the remaining messages. Iteration stops after the first
+// error, which is yielded with a zero message.
+func (r *Reader[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			m, err := r.Next()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if !yield(m, err) || err != nil {
+				return
+			}
+		}
+	}
+}
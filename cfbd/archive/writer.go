@@ -0,0 +1,118 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// Writer writes messages of type T to an archive.
+type Writer[T proto.Message] struct {
+	w      *bufio.Writer
+	header Header
+	json   protojson.MarshalOptions
+	line   bytes.Buffer
+}
+
+// NewWriter writes the archive header for T to w and returns a Writer for
+// its messages. T must be a concrete message type such as *cfbd.Game. Close
+// must be called to flush buffered messages.
+func NewWriter[T proto.Message](
+	w io.Writer, format Format,
+) (*Writer[T], error) {
+	mt, err := messageType[T](nil)
+	if err != nil {
+		return nil, err
+	}
+
+	header := newHeader(format, mt.Descriptor())
+	encoded, err := json.Marshal(header)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode archive header; %w", err)
+	}
+
+	bw := bufio.NewWriter(w)
+	switch format {
+	case FormatNDJSON:
+		encoded = append(encoded, '\n')
+	case FormatDelimited:
+		prefix := append([]byte{}, delimitedMagic...)
+		prefix = protowire.AppendVarint(prefix, uint64(len(encoded)))
+		encoded = append(prefix, encoded...)
+	default:
+		return nil, fmt.Errorf("format %q; %w", format, ErrUnknownFormat)
+	}
+	if _, err = bw.Write(encoded); err != nil {
+		return nil, fmt.Errorf("could not write archive header; %w", err)
+	}
+
+	return &Writer[T]{w: bw, header: header}, nil
+}
+
+// Header returns the header written to the archive.
+func (w *Writer[T]) Header() Header {
+	return w.header
+}
+
+// Write appends msgs to the archive.
+func (w *Writer[T]) Write(msgs ...T) error {
+	for _, m := range msgs {
+		if err := w.write(m); err != nil {
+			return fmt.Errorf("could not write %s; %w", w.header.MessageType, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *Writer[T]) write(m T) error {
+	if w.header.Format == FormatDelimited {
+		_, err := protodelim.MarshalTo(w.w, m)
+		return err
+	}
+
+	b, err := w.json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	// protojson output may contain newlines and varies its whitespace, so
+	// compact each message onto a single line.
+	w.line.Reset()
+	if err = json.Compact(&w.line, b); err != nil {
+		return err
+	}
+	w.line.WriteByte('\n')
+	_, err = w.w.Write(w.line.Bytes())
+
+	return err
+}
+
+// Close flushes buffered messages. It does not close the underlying
+// io.Writer.
+func (w *Writer[T]) Close() error {
+	if err := w.w.Flush(); err != nil {
+		return fmt.Errorf("could not flush archive; %w", err)
+	}
+
+	return nil
+}
+
+// WriteAll writes msgs to w as a complete archive.
+func WriteAll[T proto.Message](w io.Writer, format Format, msgs []T) error {
+	writer, err := NewWriter[T](w, format)
+	if err != nil {
+		return err
+	}
+	if err = writer.Write(msgs...); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
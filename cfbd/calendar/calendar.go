@@ -0,0 +1,367 @@
+// Package calendar answers "which week is it?" from the season calendar
+// returned by GetCalendar: the week containing a time, the next week, the
+// season phase and the next window of kickoffs.
+package calendar
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// ErrInvalidWeek is returned for calendar weeks without dates or ending
+// before they start.
+var ErrInvalidWeek = errors.New("invalid calendar week")
+
+// Season types used by the calendar.
+const (
+	SeasonTypeRegular    = "regular"
+	SeasonTypePostseason = "postseason"
+)
+
+// Phase is the part of the season a time falls in.
+type Phase int
+
+const (
+	// PhasePreseason is any time before the first calendar week.
+	PhasePreseason Phase = iota
+	// PhaseRegular is a regular season week, or a gap after one.
+	PhaseRegular
+	// PhasePostseason is a postseason week, or a gap after one.
+	PhasePostseason
+	// PhaseOffseason is any time after the last calendar week.
+	PhaseOffseason
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhasePreseason:
+		return "preseason"
+	case PhaseRegular:
+		return "regular"
+	case PhasePostseason:
+		return "postseason"
+	case PhaseOffseason:
+		return "offseason"
+	default:
+		return fmt.Sprintf("Phase(%d)", int(p))
+	}
+}
+
+// Week is one calendar week. Start is inclusive and End exclusive.
+type Week struct {
+	Season     int32
+	Week       int32
+	SeasonType string
+	Start      time.Time
+	End        time.Time
+}
+
+// Contains reports whether t falls in the week.
+func (w Week) Contains(t time.Time) bool {
+	return !t.Before(w.Start) && t.Before(w.End)
+}
+
+// Window is a span of kickoffs, such as a Saturday slate.
+type Window struct {
+	Week Week
+	// Start and End are the first and last kickoff in the window.
+	Start time.Time
+	End   time.Time
+	// Games is the number of kickoffs in the window, or zero when the
+	// window was taken from the calendar rather than from games.
+	Games int
+}
+
+// Option configures a Calendar.
+type Option func(*Calendar)
+
+// WithClock overrides the clock used by Current, Next, Phase and
+// NextKickoffWindow. See FakeClock.
+func WithClock(now func() time.Time) Option {
+	return func(c *Calendar) {
+		c.now = now
+	}
+}
+
+// WithGapTolerance sets the longest gap between one week's end and the next
+// week's start that is absorbed into the earlier week. Longer gaps belong
+// to no week. The default is one day.
+func WithGapTolerance(d time.Duration) Option {
+	return func(c *Calendar) {
+		c.gapTolerance = d
+	}
+}
+
+// WithGames derives kickoff windows from game start dates instead of from
+// the calendar's deprecated first and last game start fields.
+func WithGames(games []*cfbd.Game) Option {
+	return func(c *Calendar) {
+		c.games = games
+	}
+}
+
+// WithWindowGap sets how far apart consecutive kickoffs may be and still
+// fall in the same window. The default is six hours, which splits a
+// Thursday night game from Saturday's slate but keeps noon to night
+// Saturday kickoffs together.
+func WithWindowGap(d time.Duration) Option {
+	return func(c *Calendar) {
+		c.windowGap = d
+	}
+}
+
+// Calendar is a season calendar normalized into ordered, non-overlapping
+// weeks.
+type Calendar struct {
+	weeks        []Week
+	windows      []Window
+	now          func() time.Time
+	gapTolerance time.Duration
+	games        []*cfbd.Game
+	windowGap    time.Duration
+}
+
+// New builds a calendar from GetCalendar weeks.
+//
+// The API reports end_date as the last minute of a week, so End is one
+// minute later. Weeks are ordered by start; weeks sharing a start are
+// merged into the last of them, a week overlapping the next one is cut
+// short at the next start, and a gap up to the gap tolerance is absorbed
+// into the earlier week.
+func New(weeks []*cfbd.CalendarWeek, opts ...Option) (*Calendar, error) {
+	c := &Calendar{
+		now:          time.Now,
+		gapTolerance: 24 * time.Hour,
+		windowGap:    6 * time.Hour,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	sorted := slices.Clone(weeks)
+	slices.SortStableFunc(sorted, func(a, b *cfbd.CalendarWeek) int {
+		return a.GetStartDate().AsTime().Compare(b.GetStartDate().AsTime())
+	})
+	for _, cw := range sorted {
+		w, err := newWeek(cw)
+		if err != nil {
+			return nil, err
+		}
+		c.weeks = append(c.weeks, w)
+	}
+	c.weeks, sorted = merge(c.weeks, sorted)
+
+	for i := range len(c.weeks) - 1 {
+		next := c.weeks[i+1].Start
+		if next.Sub(c.weeks[i].End) <= c.gapTolerance {
+			c.weeks[i].End = next
+		}
+	}
+
+	if c.games != nil {
+		c.windows = c.gameWindows()
+		return c, nil
+	}
+	for i, cw := range sorted {
+		//nolint:staticcheck // Deprecated, but the only source without games.
+		first, last := cw.GetFirstGameStart(), cw.GetLastGameStart()
+		if first == nil || last == nil {
+			continue
+		}
+		c.windows = append(c.windows, Window{
+			Week: c.weeks[i], Start: first.AsTime(), End: last.AsTime(),
+		})
+	}
+
+	return c, nil
+}
+
+func newWeek(cw *cfbd.CalendarWeek) (Week, error) {
+	if cw.GetStartDate() == nil || cw.GetEndDate() == nil {
+		return Week{}, fmt.Errorf("%s week %d; %w",
+			cw.GetSeasonType(), cw.GetWeek(), ErrInvalidWeek)
+	}
+
+	w := Week{
+		Season:     cw.GetSeason(),
+		Week:       cw.GetWeek(),
+		SeasonType: cw.GetSeasonType(),
+		Start:      cw.GetStartDate().AsTime(),
+		End:        cw.GetEndDate().AsTime().Add(time.Minute),
+	}
+	if !w.End.After(w.Start) {
+		return Week{}, fmt.Errorf("%s week %d; %w",
+			w.SeasonType, w.Week, ErrInvalidWeek)
+	}
+
+	return w, nil
+}
+
+// merge merges weeks sharing a start into the last of them, ending at the
+// latest of their ends, so that no week is cut to nothing by the next.
+// sorted, the calendar weeks the weeks came from, is kept in step.
+func merge(
+	weeks []Week, sorted []*cfbd.CalendarWeek,
+) ([]Week, []*cfbd.CalendarWeek) {
+	outWeeks := make([]Week, 0, len(weeks))
+	outSorted := make([]*cfbd.CalendarWeek, 0, len(sorted))
+	for i, w := range weeks {
+		n := len(outWeeks)
+		if n > 0 && outWeeks[n-1].Start.Equal(w.Start) {
+			if outWeeks[n-1].End.After(w.End) {
+				w.End = outWeeks[n-1].End
+			}
+			outWeeks[n-1], outSorted[n-1] = w, sorted[i]
+			continue
+		}
+		outWeeks = append(outWeeks, w)
+		outSorted = append(outSorted, sorted[i])
+	}
+
+	return outWeeks, outSorted
+}
+
+// gameWindows groups game kickoffs into windows of consecutive kickoffs no
+// more than windowGap apart.
+func (c *Calendar) gameWindows() []Window {
+	var starts []time.Time
+	for _, g := range c.games {
+		if g.GetStartDate() != nil {
+			starts = append(starts, g.GetStartDate().AsTime())
+		}
+	}
+	slices.SortFunc(starts, time.Time.Compare)
+
+	var windows []Window
+	for _, start := range starts {
+		week, _ := c.WeekAt(start)
+		n := len(windows)
+		if n > 0 && start.Sub(windows[n-1].End) <= c.windowGap &&
+			windows[n-1].Week.Start.Equal(week.Start) {
+			windows[n-1].End = start
+			windows[n-1].Games++
+			continue
+		}
+		windows = append(windows, Window{
+			Week: week, Start: start, End: start, Games: 1,
+		})
+	}
+
+	return windows
+}
+
+// Weeks returns the normalized weeks in order.
+func (c *Calendar) Weeks() []Week {
+	return slices.Clone(c.weeks)
+}
+
+// WeekAt returns the week containing t. ok is false when t is before the
+// first week, after the last or in a gap between weeks.
+func (c *Calendar) WeekAt(t time.Time) (Week, bool) {
+	i, found := c.search(t)
+	if !found {
+		return Week{}, false
+	}
+
+	return c.weeks[i], true
+}
+
+// NextAfter returns the first week starting after t.
+func (c *Calendar) NextAfter(t time.Time) (Week, bool) {
+	i, found := c.search(t)
+	if found {
+		i++
+	}
+	if i >= len(c.weeks) {
+		return Week{}, false
+	}
+
+	return c.weeks[i], true
+}
+
+// Current returns the week containing the current time.
+func (c *Calendar) Current() (Week, bool) {
+	return c.WeekAt(c.now())
+}
+
+// Next returns the first week starting after the current time.
+func (c *Calendar) Next() (Week, bool) {
+	return c.NextAfter(c.now())
+}
+
+// CurrentOrNext returns the current week, or the next one when the current
+// time is between weeks or before the season.
+func (c *Calendar) CurrentOrNext() (Week, bool) {
+	if w, ok := c.Current(); ok {
+		return w, true
+	}
+
+	return c.Next()
+}
+
+// PhaseAt returns the part of the season t falls in. Gaps between weeks
+// belong to the phase of the week before them.
+func (c *Calendar) PhaseAt(t time.Time) Phase {
+	if len(c.weeks) == 0 || t.Before(c.weeks[0].Start) {
+		return PhasePreseason
+	}
+	if !t.Before(c.weeks[len(c.weeks)-1].End) {
+		return PhaseOffseason
+	}
+
+	i, found := c.search(t)
+	if !found {
+		i--
+	}
+	if c.weeks[i].SeasonType == SeasonTypePostseason {
+		return PhasePostseason
+	}
+
+	return PhaseRegular
+}
+
+// Phase returns the part of the season the current time falls in.
+func (c *Calendar) Phase() Phase {
+	return c.PhaseAt(c.now())
+}
+
+// KickoffWindowAfter returns the window in progress at t, or the next one
+// to start after it.
+func (c *Calendar) KickoffWindowAfter(t time.Time) (Window, bool) {
+	i := sort.Search(len(c.windows), func(i int) bool {
+		return !c.windows[i].End.Before(t)
+	})
+	if i >= len(c.windows) {
+		return Window{}, false
+	}
+
+	return c.windows[i], true
+}
+
+// NextKickoffWindow returns the kickoff window in progress now, or the next
+// one.
+func (c *Calendar) NextKickoffWindow() (Window, bool) {
+	return c.KickoffWindowAfter(c.now())
+}
+
+// search returns the index of the week containing t, or the index of the
+// first week starting after t.
+func (c *Calendar) search(t time.Time) (int, bool) {
+	i, _ := slices.BinarySearchFunc(c.weeks, t, func(w Week, t time.Time) int {
+		return cmp.Compare(w.Start.UnixNano(), t.UnixNano())
+	})
+	if i < len(c.weeks) && c.weeks[i].Start.Equal(t) {
+		return i, true
+	}
+	if i > 0 && c.weeks[i-1].Contains(t) {
+		return i - 1, true
+	}
+
+	return i, false
+}
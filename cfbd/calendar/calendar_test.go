@@ -0,0 +1,203 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func loadWeeks(t *testing.T) []*cfbd.CalendarWeek {
+	return fixture.Load(t, "calendar.json",
+		func() *cfbd.CalendarWeek { return &cfbd.CalendarWeek{} })
+}
+
+func at(t *testing.T, s string) time.Time {
+	t.Helper()
+
+	tm, err := time.Parse(time.RFC3339, s)
+	require.NoError(t, err)
+
+	return tm
+}
+
+func newFixtureCalendar(
+	t *testing.T, opts ...Option,
+) (*Calendar, *FakeClock) {
+	clock := NewFakeClock(at(t, "2025-09-06T18:00:00Z"))
+	c, err := New(loadWeeks(t), append([]Option{WithClock(clock.Now)},
+		opts...)...)
+	require.NoError(t, err)
+
+	return c, clock
+}
+
+func TestNew_Fixture_ShouldTrimOverlappingWeek(t *testing.T) {
+	c, _ := newFixtureCalendar(t)
+
+	weeks := c.Weeks()
+	require.Len(t, weeks, 17)
+	week16, post := weeks[15], weeks[16]
+	assert.Equal(t, int32(16), week16.Week)
+	assert.Equal(t, SeasonTypePostseason, post.SeasonType)
+	// The fixture's week 16 ends a year late, on top of the postseason.
+	assert.Equal(t, post.Start, week16.End)
+	for i := range len(weeks) - 1 {
+		assert.Equal(t, weeks[i+1].Start, weeks[i].End, i)
+	}
+}
+
+func TestCurrent_FakeClock_ShouldFollowClock(t *testing.T) {
+	c, clock := newFixtureCalendar(t)
+
+	w, ok := c.Current()
+	require.True(t, ok)
+	assert.Equal(t, int32(2), w.Week)
+	next, ok := c.Next()
+	require.True(t, ok)
+	assert.Equal(t, int32(3), next.Week)
+
+	clock.Advance(7 * 24 * time.Hour)
+	w, ok = c.Current()
+	require.True(t, ok)
+	assert.Equal(t, int32(3), w.Week)
+}
+
+func TestWeekAt_Boundaries_ShouldSplitOnStart(t *testing.T) {
+	c, _ := newFixtureCalendar(t)
+
+	for ts, want := range map[string]int32{
+		"2025-08-23T07:00:00Z": 1,
+		"2025-09-02T06:59:00Z": 1,
+		"2025-09-02T06:59:30Z": 1,
+		"2025-09-02T07:00:00Z": 2,
+		"2025-11-03T07:59:59Z": 10,
+		"2025-11-03T08:00:00Z": 11,
+	} {
+		w, ok := c.WeekAt(at(t, ts))
+		require.True(t, ok, ts)
+		assert.Equal(t, want, w.Week, ts)
+	}
+}
+
+func TestPhaseAt_ShouldCoverWholeYear(t *testing.T) {
+	c, clock := newFixtureCalendar(t)
+
+	for ts, want := range map[string]Phase{
+		"2025-06-01T00:00:00Z": PhasePreseason,
+		"2025-10-01T00:00:00Z": PhaseRegular,
+		"2025-12-14T00:00:00Z": PhasePostseason,
+		"2026-01-21T07:59:00Z": PhasePostseason,
+		"2026-01-21T08:00:00Z": PhaseOffseason,
+	} {
+		assert.Equal(t, want, c.PhaseAt(at(t, ts)), ts)
+	}
+
+	clock.Set(at(t, "2025-12-20T00:00:00Z"))
+	assert.Equal(t, PhasePostseason, c.Phase())
+	assert.Equal(t, "postseason", c.Phase().String())
+}
+
+func TestWeekAt_Gap_ShouldBelongToNoWeek(t *testing.T) {
+	c, err := New([]*cfbd.CalendarWeek{
+		{
+			Season: 2025, Week: 15, SeasonType: SeasonTypeRegular,
+			StartDate: timestamppb.New(at(t, "2025-12-01T08:00:00Z")),
+			EndDate:   timestamppb.New(at(t, "2025-12-08T07:59:00Z")),
+		},
+		{
+			Season: 2025, Week: 1, SeasonType: SeasonTypePostseason,
+			StartDate: timestamppb.New(at(t, "2025-12-19T08:00:00Z")),
+			EndDate:   timestamppb.New(at(t, "2026-01-21T07:59:00Z")),
+		},
+	}, WithClock(NewFakeClock(at(t, "2025-12-12T00:00:00Z")).Now))
+	require.NoError(t, err)
+
+	_, ok := c.Current()
+	assert.False(t, ok)
+	assert.Equal(t, PhaseRegular, c.Phase())
+	w, ok := c.CurrentOrNext()
+	require.True(t, ok)
+	assert.Equal(t, SeasonTypePostseason, w.SeasonType)
+	_, ok = c.NextAfter(at(t, "2026-02-01T00:00:00Z"))
+	assert.False(t, ok)
+}
+
+func TestNew_SharedStart_ShouldMergeWeeks(t *testing.T) {
+	c, err := New([]*cfbd.CalendarWeek{
+		{
+			Season: 2025, Week: 15, SeasonType: SeasonTypeRegular,
+			StartDate: timestamppb.New(at(t, "2025-12-01T08:00:00Z")),
+			EndDate:   timestamppb.New(at(t, "2025-12-08T07:59:00Z")),
+		},
+		{
+			Season: 2025, Week: 16, SeasonType: SeasonTypeRegular,
+			StartDate: timestamppb.New(at(t, "2025-12-01T08:00:00Z")),
+			EndDate:   timestamppb.New(at(t, "2025-12-02T07:59:00Z")),
+		},
+	})
+	require.NoError(t, err)
+
+	weeks := c.Weeks()
+	require.Len(t, weeks, 1)
+	assert.Equal(t, int32(16), weeks[0].Week)
+	assert.Equal(t, at(t, "2025-12-08T08:00:00Z"), weeks[0].End)
+	w, ok := c.WeekAt(at(t, "2025-12-05T00:00:00Z"))
+	require.True(t, ok)
+	assert.Equal(t, int32(16), w.Week)
+}
+
+func TestNew_MissingDates_ShouldError(t *testing.T) {
+	_, err := New([]*cfbd.CalendarWeek{{Season: 2025, Week: 1}})
+
+	assert.ErrorIs(t, err, ErrInvalidWeek)
+}
+
+func TestNextKickoffWindow_Games_ShouldGroupSlates(t *testing.T) {
+	game := func(ts string) *cfbd.Game {
+		return &cfbd.Game{StartDate: timestamppb.New(at(t, ts))}
+	}
+	c, clock := newFixtureCalendar(t, WithGames([]*cfbd.Game{
+		game("2025-09-06T16:00:00Z"),
+		game("2025-09-06T19:30:00Z"),
+		game("2025-09-07T00:00:00Z"),
+		game("2025-09-11T23:30:00Z"),
+		game("2025-09-13T16:00:00Z"),
+		game("2025-09-13T21:30:00Z"),
+	}))
+
+	w, ok := c.NextKickoffWindow()
+	require.True(t, ok)
+	assert.Equal(t, at(t, "2025-09-06T16:00:00Z"), w.Start)
+	assert.Equal(t, at(t, "2025-09-07T00:00:00Z"), w.End)
+	assert.Equal(t, 3, w.Games)
+	assert.Equal(t, int32(2), w.Week.Week)
+
+	clock.Set(at(t, "2025-09-08T00:00:00Z"))
+	w, ok = c.NextKickoffWindow()
+	require.True(t, ok)
+	assert.Equal(t, 1, w.Games)
+	assert.Equal(t, int32(3), w.Week.Week)
+
+	w, ok = c.KickoffWindowAfter(at(t, "2025-09-12T00:00:00Z"))
+	require.True(t, ok)
+	assert.Equal(t, 2, w.Games)
+
+	_, ok = c.KickoffWindowAfter(at(t, "2025-09-14T00:00:00Z"))
+	assert.False(t, ok)
+}
+
+func TestNextKickoffWindow_NoGames_ShouldUseCalendar(t *testing.T) {
+	c, _ := newFixtureCalendar(t)
+
+	w, ok := c.NextKickoffWindow()
+
+	require.True(t, ok)
+	assert.Equal(t, int32(2), w.Week.Week)
+	assert.Equal(t, at(t, "2025-09-02T07:00:00Z"), w.Start)
+	assert.Zero(t, w.Games)
+}
@@ -111,9 +111,29 @@ type Client struct {
 	httpGet      httpGetExecutor
 }
 
+// Option configures optional behavior of a Client created with New.
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	baseURL string
+}
+
+// WithBaseURL overrides the CFBD API base URL, e.g. to point the client at a
+// local stand-in server serving recorded fixtures.
+func WithBaseURL(baseURL string) Option {
+	return func(o *clientOptions) {
+		o.baseURL = baseURL
+	}
+}
+
 // New creates a new Client.
-func New(apiKey string) (*Client, error) {
-	base, err := url.Parse(baseURL)
+func New(apiKey string, opts ...Option) (*Client, error) {
+	options := clientOptions{baseURL: baseURL}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	base, err := url.Parse(options.baseURL)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse base url; %w", err)
 	}
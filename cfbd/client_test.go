@@ -414,6 +414,34 @@ func TestGetScoreboard_ValidRequest_ShouldSucceed(t *testing.T) {
 		score.LastPlay,
 		"(00:45) Kneel down by Navy at Army42 for loss of 2 yards",
 	)
+
+	assert.Equal(t, score.Venue.Name, "M&T Bank Stadium")
+	assert.Equal(t, score.Venue.City, "Baltimore")
+	assert.Equal(t, score.Venue.State, "MD")
+
+	assert.Equal(t, score.HomeTeam.Id, int32(2426))
+	assert.Equal(t, score.HomeTeam.Name, "Navy Midshipmen")
+	assert.Equal(t, score.HomeTeam.Conference, "American Athletic")
+	assert.Equal(t, score.HomeTeam.Classification, "fbs")
+	assert.Equal(t, *score.HomeTeam.Points, int32(17))
+	assert.Equal(t, score.HomeTeam.LineScores, []int32{7, 0, 3, 7})
+	assert.Nil(t, score.HomeTeam.WinProbability)
+
+	assert.Equal(t, score.AwayTeam.Id, int32(349))
+	assert.Equal(t, score.AwayTeam.Name, "Army Black Knights")
+	assert.Equal(t, *score.AwayTeam.Points, int32(16))
+	assert.Equal(t, score.AwayTeam.LineScores, []int32{0, 13, 3, 0})
+	assert.Nil(t, score.AwayTeam.WinProbability)
+
+	assert.Equal(t, *score.Weather.Temperature, 42.8)
+	assert.Equal(t, score.Weather.Description, "Cloudy")
+	assert.Equal(t, *score.Weather.WindSpeed, 5.2)
+	assert.Equal(t, *score.Weather.WindDirection, 211.0)
+
+	assert.Equal(t, *score.Betting.Spread, -6.0)
+	assert.Equal(t, *score.Betting.OverUnder, 38.0)
+	assert.Equal(t, *score.Betting.HomeMoneyline, -200.0)
+	assert.Equal(t, *score.Betting.AwayMoneyline, 170.0)
 }
 
 func TestGetAdvancedBoxScore_ValidRequest_ShouldSucceed(t *testing.T) {
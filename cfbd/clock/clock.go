@@ -0,0 +1,242 @@
+// Package clock converts the game clocks returned by the CFBD API, which
+// come as ClockInt32 and ClockDouble messages or "mm:ss" strings depending
+// on the endpoint, into a single GameClock that can be compared and turned
+// into elapsed game time.
+package clock
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+const (
+	// RegulationPeriods is the number of quarters in regulation.
+	RegulationPeriods = 4
+	// PeriodLength is the length of a quarter.
+	PeriodLength = 15 * time.Minute
+	// RegulationLength is the length of regulation play.
+	RegulationLength = RegulationPeriods * PeriodLength
+)
+
+// ErrInvalidClock is returned when a clock string is not "mm:ss" or a number
+// of seconds.
+var ErrInvalidClock = errors.New("invalid game clock")
+
+// GameClock is a point in a game: a period and the time remaining in it.
+// Periods after RegulationPeriods are overtime. College overtime is untimed,
+// so the API reports whatever clock the feed carried; each overtime period
+// is counted as PeriodLength long so that elapsed time keeps increasing
+// across periods.
+type GameClock struct {
+	Period    int32
+	Remaining time.Duration
+}
+
+// New returns the clock with remaining time left in period, clamped to
+// [0, PeriodLength].
+func New(period int32, remaining time.Duration) GameClock {
+	return GameClock{
+		Period:    period,
+		Remaining: min(max(remaining, 0), PeriodLength),
+	}
+}
+
+// FromInt32 converts a ClockInt32 as used by Play and Drive. A nil clock
+// reads as 0:00.
+func FromInt32(period int32, c *cfbd.ClockInt32) GameClock {
+	return New(period, Int32Duration(c))
+}
+
+// FromDouble converts a ClockDouble as used by PlayStat.
+func FromDouble(period int32, c *cfbd.ClockDouble) GameClock {
+	return New(period, DoubleDuration(c))
+}
+
+// Parse converts an "mm:ss" or seconds string as used by LiveGamePlay.
+func Parse(period int32, s string) (GameClock, error) {
+	d, err := ParseDuration(s)
+	if err != nil {
+		return GameClock{}, err
+	}
+
+	return New(period, d), nil
+}
+
+// Int32Duration converts a ClockInt32 holding a span of time, such as
+// Drive.elapsed, into a duration.
+func Int32Duration(c *cfbd.ClockInt32) time.Duration {
+	return time.Duration(c.GetMinutes())*time.Minute +
+		time.Duration(c.GetSeconds())*time.Second
+}
+
+// DoubleDuration converts a ClockDouble into a duration, rounded to the
+// millisecond.
+func DoubleDuration(c *cfbd.ClockDouble) time.Duration {
+	secs := c.GetMinutes()*60 + c.GetSeconds()
+	ms := math.Round(secs * 1000)
+
+	return time.Duration(ms) * time.Millisecond
+}
+
+// ParseDuration parses "mm:ss", "m:ss.f" or a plain number of seconds, as
+// used by LiveGameDrive.duration.
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	minutes, seconds, found := strings.Cut(s, ":")
+	if !found {
+		minutes, seconds = "0", s
+	}
+
+	m, err := strconv.Atoi(minutes)
+	if err != nil || m < 0 {
+		return 0, fmt.Errorf("%q; %w", s, ErrInvalidClock)
+	}
+	sec, err := strconv.ParseFloat(seconds, 64)
+	if err != nil || sec < 0 || math.IsNaN(sec) || (found && sec >= 60) {
+		return 0, fmt.Errorf("%q; %w", s, ErrInvalidClock)
+	}
+
+	return time.Duration(m)*time.Minute +
+		time.Duration(math.Round(sec*1000))*time.Millisecond, nil
+}
+
+// Overtime reports whether the clock is in an overtime period.
+func (c GameClock) Overtime() bool {
+	return c.Period > RegulationPeriods
+}
+
+// ElapsedInPeriod returns the time played so far in the period.
+func (c GameClock) ElapsedInPeriod() time.Duration {
+	return PeriodLength - c.Remaining
+}
+
+// Elapsed returns the time played since kickoff, counting every completed
+// period, overtime included, as PeriodLength.
+func (c GameClock) Elapsed() time.Duration {
+	if c.Period < 1 {
+		return 0
+	}
+
+	return time.Duration(c.Period-1)*PeriodLength + c.ElapsedInPeriod()
+}
+
+// ElapsedSeconds returns Elapsed in whole seconds.
+func (c GameClock) ElapsedSeconds() int {
+	return int(c.Elapsed() / time.Second)
+}
+
+// RemainingInRegulation returns the time left in regulation, or zero in
+// overtime.
+func (c GameClock) RemainingInRegulation() time.Duration {
+	return max(RegulationLength-c.Elapsed(), 0)
+}
+
+// Sub returns the game time from earlier to c. It is negative when earlier
+// is later in the game than c.
+func (c GameClock) Sub(earlier GameClock) time.Duration {
+	return c.Elapsed() - earlier.Elapsed()
+}
+
+// Compare returns -1 if c is earlier in the game than o, +1 if it is later
+// and 0 if they are the same moment. It can be passed to slices.SortFunc.
+func (c GameClock) Compare(o GameClock) int {
+	switch {
+	case c.Period != o.Period:
+		if c.Period < o.Period {
+			return -1
+		}
+		return 1
+	case c.Remaining > o.Remaining:
+		return -1
+	case c.Remaining < o.Remaining:
+		return 1
+	}
+
+	return 0
+}
+
+// Before reports whether c is earlier in the game than o.
+func (c GameClock) Before(o GameClock) bool {
+	return c.Compare(o) < 0
+}
+
+// After reports whether c is later in the game than o.
+func (c GameClock) After(o GameClock) bool {
+	return c.Compare(o) > 0
+}
+
+// String formats the clock as "Q2 13:05", or "OT1 15:00" in overtime.
+func (c GameClock) String() string {
+	secs := int(c.Remaining / time.Second)
+	mmss := fmt.Sprintf("%d:%02d", secs/60, secs%60)
+	if c.Overtime() {
+		return fmt.Sprintf("OT%d %s", c.Period-RegulationPeriods, mmss)
+	}
+
+	return fmt.Sprintf("Q%d %s", c.Period, mmss)
+}
+
+// Play returns the clock at the snap of a play from GetPlays.
+func Play(p *cfbd.Play) GameClock {
+	return FromInt32(p.GetPeriod(), p.GetClock())
+}
+
+// PlayStat returns the clock of a play stat from GetPlayStats.
+func PlayStat(s *cfbd.PlayStat) GameClock {
+	return FromDouble(int32(s.GetPeriod()), s.GetClock())
+}
+
+// DriveStart returns the clock when a drive from GetDrives began.
+func DriveStart(d *cfbd.Drive) GameClock {
+	return FromInt32(d.GetStartPeriod(), d.GetStartTime())
+}
+
+// DriveEnd returns the clock when a drive from GetDrives ended.
+func DriveEnd(d *cfbd.Drive) GameClock {
+	return FromInt32(d.GetEndPeriod(), d.GetEndTime())
+}
+
+// DriveElapsed returns the game time a drive from GetDrives took, as
+// reported by the API.
+func DriveElapsed(d *cfbd.Drive) time.Duration {
+	return Int32Duration(d.GetElapsed())
+}
+
+// LivePlay returns the clock of a play from GetLivePlays.
+func LivePlay(p *cfbd.LiveGamePlay) (GameClock, error) {
+	return Parse(p.GetPeriod(), p.GetClock())
+}
+
+// LiveDriveStart returns the clock when a live drive began. ok is false
+// when the feed has not reported it yet.
+func LiveDriveStart(d *cfbd.LiveGameDrive) (GameClock, bool, error) {
+	if d.GetStartClock() == "" {
+		return GameClock{}, false, nil
+	}
+	c, err := Parse(d.GetStartPeriod(), d.GetStartClock())
+
+	return c, err == nil, err
+}
+
+// LiveDriveEnd returns the clock when a live drive ended. ok is false while
+// the drive is in progress.
+func LiveDriveEnd(d *cfbd.LiveGameDrive) (GameClock, bool, error) {
+	if d.EndPeriod == nil || d.GetEndClock() == "" {
+		return GameClock{}, false, nil
+	}
+	c, err := Parse(d.GetEndPeriod(), d.GetEndClock())
+
+	return c, err == nil, err
+}
+
+// LiveDriveDuration returns the game time a live drive took, as reported by
+// the API.
+func LiveDriveDuration(d *cfbd.LiveGameDrive) (time.Duration, error) {
+	return ParseDuration(d.GetDuration())
+}
@@ -0,0 +1,172 @@
+package clock
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFromInt32_ShouldConvertMinutesAndSeconds(t *testing.T) {
+	c := FromInt32(2, &cfbd.ClockInt32{
+		Minutes: proto.Int32(13), Seconds: proto.Int32(5),
+	})
+
+	assert.Equal(t, 13*time.Minute+5*time.Second, c.Remaining)
+	assert.Equal(t, 16*time.Minute+55*time.Second, c.Elapsed())
+	assert.Equal(t, 1015, c.ElapsedSeconds())
+	assert.Equal(t, "Q2 13:05", c.String())
+}
+
+func TestFromInt32_Nil_ShouldReadAsZero(t *testing.T) {
+	c := FromInt32(4, nil)
+
+	assert.Zero(t, c.Remaining)
+	assert.Equal(t, RegulationLength, c.Elapsed())
+}
+
+func TestFromDouble_ShouldMatchInt32(t *testing.T) {
+	d := FromDouble(1, &cfbd.ClockDouble{
+		Minutes: proto.Float64(14), Seconds: proto.Float64(50),
+	})
+	i := FromInt32(1, &cfbd.ClockInt32{
+		Minutes: proto.Int32(14), Seconds: proto.Int32(50),
+	})
+
+	assert.Equal(t, i, d)
+	assert.Zero(t, d.Compare(i))
+}
+
+func TestParse_Shapes_ShouldMatchStructuredClocks(t *testing.T) {
+	for raw, want := range map[string]time.Duration{
+		"15:00":  PeriodLength,
+		"0:07":   7 * time.Second,
+		"07:50":  7*time.Minute + 50*time.Second,
+		"42":     42 * time.Second,
+		"0:03.5": 3500 * time.Millisecond,
+	} {
+		c, err := Parse(1, raw)
+		require.NoError(t, err, raw)
+		assert.Equal(t, want, c.Remaining, raw)
+	}
+}
+
+func TestParse_Invalid_ShouldError(t *testing.T) {
+	for _, raw := range []string{"", "ab:cd", "1:75", "-3", "NaN"} {
+		_, err := Parse(1, raw)
+		assert.ErrorIs(t, err, ErrInvalidClock, raw)
+	}
+}
+
+func TestNew_OutOfRange_ShouldClamp(t *testing.T) {
+	assert.Equal(t, PeriodLength, New(1, time.Hour).Remaining)
+	assert.Zero(t, New(1, -time.Second).Remaining)
+}
+
+func TestElapsed_Overtime_ShouldKeepIncreasing(t *testing.T) {
+	endOfRegulation := New(4, 0)
+	overtime := New(5, PeriodLength)
+	secondOvertime := New(6, PeriodLength)
+
+	assert.True(t, overtime.Overtime())
+	assert.Equal(t, RegulationLength, overtime.Elapsed())
+	assert.Zero(t, overtime.RemainingInRegulation())
+	assert.Equal(t, PeriodLength, secondOvertime.Sub(overtime))
+	assert.True(t, endOfRegulation.Before(secondOvertime))
+	assert.Equal(t, "OT2 15:00", secondOvertime.String())
+}
+
+func TestCompare_ShouldOrderByPeriodThenRemaining(t *testing.T) {
+	clocks := []GameClock{
+		New(2, 30*time.Second),
+		New(1, 0),
+		New(5, PeriodLength),
+		New(2, 10*time.Minute),
+		New(1, PeriodLength),
+	}
+
+	slices.SortFunc(clocks, GameClock.Compare)
+
+	assert.Equal(t, []GameClock{
+		New(1, PeriodLength),
+		New(1, 0),
+		New(2, 10*time.Minute),
+		New(2, 30*time.Second),
+		New(5, PeriodLength),
+	}, clocks)
+	assert.True(t, clocks[1].After(clocks[0]))
+	assert.Equal(t, time.Duration(0), New(1, 0).Sub(New(2, PeriodLength)))
+}
+
+func TestDriveStartEnd_DrivesFixture_ShouldMatchElapsed(t *testing.T) {
+	drives := fixture.Load(t, "drives.json",
+		func() *cfbd.Drive { return &cfbd.Drive{} })
+	require.NotEmpty(t, drives)
+
+	for _, d := range drives {
+		assert.Equal(t, DriveElapsed(d), DriveEnd(d).Sub(DriveStart(d)),
+			d.GetId())
+	}
+}
+
+func TestPlayStat_PlaysStatsFixture_ShouldConvert(t *testing.T) {
+	stats := fixture.Load(t, "plays_stats.json",
+		func() *cfbd.PlayStat { return &cfbd.PlayStat{} })
+	require.NotEmpty(t, stats)
+
+	c := PlayStat(stats[0])
+
+	assert.Equal(t, New(1, 14*time.Minute+50*time.Second), c)
+}
+
+func TestLivePlay_LivePlaysFixture_ShouldBeChronological(t *testing.T) {
+	var game cfbd.LiveGame
+	require.NoError(t, protojson.UnmarshalOptions{DiscardUnknown: true}.
+		Unmarshal(fixture.Read(t, "live_plays.json"), &game))
+	require.NotEmpty(t, game.GetDrives())
+
+	var last GameClock
+	for _, d := range game.GetDrives() {
+		start, ok, err := LiveDriveStart(d)
+		require.NoError(t, err, d.GetId())
+		if ok {
+			assert.False(t, start.Before(last), d.GetId())
+		}
+
+		_, err = LiveDriveDuration(d)
+		require.NoError(t, err, d.GetId())
+
+		for _, p := range d.GetPlays() {
+			c, err := LivePlay(p)
+			require.NoError(t, err, p.GetId())
+			assert.False(t, c.Before(last), p.GetId())
+			last = c
+		}
+
+		end, ok, err := LiveDriveEnd(d)
+		require.NoError(t, err, d.GetId())
+		if ok {
+			assert.False(t, end.Before(last), d.GetId())
+		}
+	}
+}
+
+func TestLiveDriveEnd_InProgress_ShouldNotBeOK(t *testing.T) {
+	_, ok, err := LiveDriveEnd(&cfbd.LiveGameDrive{StartPeriod: 3})
+
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestLiveDriveStart_Unreported_ShouldNotBeOK(t *testing.T) {
+	_, ok, err := LiveDriveStart(&cfbd.LiveGameDrive{StartPeriod: 1})
+
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,175 @@
+// Package drives rebuilds team drive metrics from GetDrives: points per
+// drive, available yards, three-and-outs, scoring opportunities, starting
+// field position and tempo. Metrics are broken down by quarter and game
+// state and can be adjusted for the strength of the opponent.
+package drives
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// Source is the subset of *cfbd.Client used by Build.
+type Source interface {
+	GetDrives(
+		ctx context.Context, request cfbd.GetDrivesRequest,
+	) ([]*cfbd.Drive, error)
+}
+
+var _ Source = (*cfbd.Client)(nil)
+
+// Ratings rates teams for opponent adjustment. Any strength.Rater will do.
+type Ratings interface {
+	// Rating returns the rating of team, or false when it is not rated.
+	Rating(team string) (float64, bool)
+}
+
+// Map rates teams from a map.
+type Map map[string]float64
+
+var _ Ratings = Map(nil)
+
+// Rating returns the rating of team.
+func (m Map) Rating(team string) (float64, bool) {
+	r, ok := m[team]
+	return r, ok
+}
+
+// Option configures an Analyzer.
+type Option func(*Analyzer)
+
+// WithExclude leaves out the drives exclude reports true for, such as
+// garbage.Classifier.Drive.
+func WithExclude(exclude func(*cfbd.Drive) bool) Option {
+	return func(a *Analyzer) {
+		a.exclude = exclude
+	}
+}
+
+// WithRatings adjusts points per drive for the opponent. Each point an
+// opponent is rated above the mean opponent is worth perPoint points per
+// drive: added to the offense's points and taken from the points the
+// defense allowed. Unrated opponents count as the mean opponent.
+func WithRatings(r Ratings, perPoint float64) Option {
+	return func(a *Analyzer) {
+		a.ratings, a.perPoint = r, perPoint
+	}
+}
+
+// Team is a team's drives on offense and the drives it faced on defense.
+type Team struct {
+	Team       string
+	Conference string
+	Offense    Breakdown
+	Defense    Breakdown
+}
+
+// Analyzer aggregates drives by team.
+type Analyzer struct {
+	exclude  func(*cfbd.Drive) bool
+	ratings  Ratings
+	perPoint float64
+}
+
+// New returns an analyzer.
+func New(opts ...Option) *Analyzer {
+	a := &Analyzer{}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// Build fetches drives from src and aggregates them by team.
+func Build(
+	ctx context.Context, src Source, request cfbd.GetDrivesRequest,
+	opts ...Option,
+) ([]Team, error) {
+	drives, err := src.GetDrives(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("could not get drives; %w", err)
+	}
+
+	return New(opts...).Teams(drives), nil
+}
+
+// Teams aggregates drives by team, ordered by team.
+func (a *Analyzer) Teams(drives []*cfbd.Drive) []Team {
+	var kept []*cfbd.Drive
+	for _, d := range drives {
+		if a.exclude == nil || !a.exclude(d) {
+			kept = append(kept, d)
+		}
+	}
+	benchmark := a.benchmark(kept)
+
+	teams := make(map[string]*Team)
+	get := func(team, conference string) *Team {
+		t, ok := teams[team]
+		if !ok {
+			t = &Team{Team: team, Conference: conference}
+			teams[team] = t
+		}
+		return t
+	}
+	for _, d := range kept {
+		points := float64(Points(d))
+		get(d.GetOffense(), d.GetOffenseConference()).Offense.add(d,
+			d.GetStartOffenseScore()-d.GetStartDefenseScore(),
+			points+a.adjustment(d.GetDefense(), benchmark))
+		get(d.GetDefense(), d.GetDefenseConference()).Defense.add(d,
+			d.GetStartDefenseScore()-d.GetStartOffenseScore(),
+			points-a.adjustment(d.GetOffense(), benchmark))
+	}
+
+	out := make([]Team, 0, len(teams))
+	for _, t := range teams {
+		out = append(out, *t)
+	}
+	slices.SortFunc(out, func(a, b Team) int {
+		return cmp.Compare(a.Team, b.Team)
+	})
+
+	return out
+}
+
+// benchmark returns the mean rating of the rated opponents of every
+// drive, on both sides of the ball.
+func (a *Analyzer) benchmark(drives []*cfbd.Drive) float64 {
+	if a.ratings == nil {
+		return 0
+	}
+	var sum float64
+	var n int
+	for _, d := range drives {
+		for _, team := range []string{d.GetOffense(), d.GetDefense()} {
+			if r, ok := a.ratings.Rating(team); ok {
+				sum += r
+				n++
+			}
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+
+	return sum / float64(n)
+}
+
+// adjustment returns the points per drive that facing opponent is worth.
+func (a *Analyzer) adjustment(opponent string, benchmark float64) float64 {
+	if a.ratings == nil {
+		return 0
+	}
+	r, ok := a.ratings.Rating(opponent)
+	if !ok {
+		return 0
+	}
+
+	return a.perPoint * (r - benchmark)
+}
@@ -0,0 +1,158 @@
+package drives
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/garbage"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func drive(
+	offense, defense string, period, ytg int32, result string,
+	plays, yards int32,
+) *cfbd.Drive {
+	return &cfbd.Drive{
+		Offense:          offense,
+		Defense:          defense,
+		StartPeriod:      period,
+		StartYardsToGoal: ytg,
+		EndYardsToGoal:   ytg - yards,
+		DriveResult:      result,
+		Plays:            plays,
+		Yards:            yards,
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func team(t *testing.T, teams []Team, name string) Team {
+	for _, tm := range teams {
+		if tm.Team == name {
+			return tm
+		}
+	}
+	require.Fail(t, "missing team", name)
+
+	return Team{}
+}
+
+type fakeSource struct {
+	drives []*cfbd.Drive
+	err    error
+}
+
+func (f fakeSource) GetDrives(
+	context.Context, cfbd.GetDrivesRequest,
+) ([]*cfbd.Drive, error) {
+	return f.drives, f.err
+}
+
+func TestBuild_Fixture_ShouldAggregateBothSides(t *testing.T) {
+	src := fakeSource{
+		drives: fixture.Load(t, "drives.json", func() *cfbd.Drive {
+			return &cfbd.Drive{}
+		}),
+	}
+
+	teams, err := Build(context.Background(), src,
+		cfbd.GetDrivesRequest{Year: 2025})
+	require.NoError(t, err)
+	require.Len(t, teams, 2)
+	assert.Equal(t, "San José State", teams[0].Team)
+
+	texas := team(t, teams, "Texas")
+	assert.Equal(t, "SEC", texas.Conference)
+	off := texas.Offense.All
+	assert.Equal(t, 1, off.Drives)
+	assert.Equal(t, 1, off.ThreeAndOuts)
+	assert.Equal(t, 1, off.Starts[Own])
+	assert.InDelta(t, 16.5, off.SecondsPerPlay(), 1e-9)
+	assert.InDelta(t, -4.0/75, off.AvailableYardsRate(), 1e-9)
+	assert.Equal(t, 1, texas.Offense.Quarter(1).Drives)
+	assert.Equal(t, 1, texas.Offense.State(Tied).Drives)
+	assert.Zero(t, texas.Defense.All.Drives)
+
+	sjsu := team(t, teams, "San José State")
+	assert.Equal(t, 1, sjsu.Defense.All.ThreeAndOuts)
+}
+
+func TestBuild_SourceError_ShouldWrap(t *testing.T) {
+	boom := errors.New("boom")
+
+	_, err := Build(context.Background(), fakeSource{err: boom},
+		cfbd.GetDrivesRequest{Year: 2025})
+
+	require.ErrorIs(t, err, boom)
+}
+
+func TestTeams_ShouldBreakDownByQuarterAndState(t *testing.T) {
+	early := drive("A", "B", 1, 75, "PUNT", 4, 3)
+	behind := drive("A", "B", 3, 75, "PUNT", 6, 20)
+	behind.StartDefenseScore = 14
+	ot := drive("A", "B", 6, 25, "FG", 4, 7)
+	ot.Scoring, ot.StartOffenseScore, ot.StartDefenseScore = true, 24, 24
+
+	a := team(t, New().Teams([]*cfbd.Drive{early, behind, ot}), "A")
+	b := team(t, New().Teams([]*cfbd.Drive{early, behind, ot}), "B")
+
+	assert.Equal(t, 1, a.Offense.Quarter(1).Drives)
+	assert.Equal(t, 1, a.Offense.Quarter(3).Drives)
+	assert.Equal(t, 1, a.Offense.Quarter(5).Drives)
+	assert.Equal(t, 3, a.Offense.Quarters[overtime].Points)
+	assert.Zero(t, a.Offense.Quarter(0).Drives)
+	assert.Equal(t, 2, a.Offense.State(Tied).Drives)
+	assert.Equal(t, 1, a.Offense.State(TrailingBig).Drives)
+	assert.Equal(t, 1, b.Defense.State(LeadingBig).Drives)
+	assert.Zero(t, a.Offense.State(State(7)).Drives)
+}
+
+func TestTeams_WithExclude_ShouldSkipGarbageTime(t *testing.T) {
+	tight := drive("A", "B", 4, 75, "TD", 8, 75)
+	tight.Scoring, tight.EndOffenseScore = true, 7
+	blowout := drive("A", "B", 4, 75, "TD", 8, 75)
+	blowout.Scoring = true
+	blowout.StartOffenseScore, blowout.EndOffenseScore = 35, 42
+	drives := []*cfbd.Drive{tight, blowout}
+
+	all := team(t, New().Teams(drives), "A")
+	kept := team(t, New(WithExclude(garbage.New().Drive)).Teams(drives), "A")
+
+	assert.Equal(t, 2, all.Offense.All.Drives)
+	assert.Equal(t, 1, kept.Offense.All.Drives)
+}
+
+func TestTeams_WithRatings_ShouldAdjustForOpponent(t *testing.T) {
+	drives := []*cfbd.Drive{
+		drive("A", "Strong", 1, 75, "PUNT", 4, 3),
+		drive("A", "Weak", 1, 75, "PUNT", 4, 3),
+		drive("B", "Weak", 1, 75, "PUNT", 4, 3),
+		drive("B", "Unrated", 1, 75, "PUNT", 4, 3),
+	}
+	ratings := Map{"A": 0, "B": 0, "Strong": 20, "Weak": -10}
+
+	teams := New(WithRatings(ratings, 0.1)).Teams(drives)
+
+	// The mean rating over both sides of every drive is 0.
+	a, b := team(t, teams, "A"), team(t, teams, "B")
+	assert.Zero(t, a.Offense.All.PointsPerDrive())
+	assert.InDelta(t, 0.5, a.Offense.All.AdjustedPointsPerDrive(), 1e-9)
+	assert.InDelta(t, -0.5, b.Offense.All.AdjustedPointsPerDrive(), 1e-9)
+	assert.InDelta(t, 0, team(t, teams, "Strong").Defense.All.
+		AdjustedPointsPerDrive(), 1e-9)
+}
+
+func TestTeams_WithoutRatings_ShouldLeavePointsUnadjusted(t *testing.T) {
+	td := drive("A", "B", 1, 75, "TD", 8, 75)
+	td.Scoring, td.EndOffenseScore = true, 7
+
+	a := team(t, New().Teams([]*cfbd.Drive{td}), "A")
+
+	assert.InDelta(t, 7.0, a.Offense.All.AdjustedPointsPerDrive(), 1e-9)
+}
@@ -0,0 +1,328 @@
+package drives
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/clock"
+)
+
+// Point values of the offense's scores when the drive's score change is
+// missing.
+const (
+	Touchdown = 7
+	FieldGoal = 3
+)
+
+// Drive results, as GetDrives names them.
+var (
+	touchdowns = []string{"TD", "PASSING TD", "RUSHING TD"}
+	fieldGoals = []string{"FG", "FG GOOD"}
+	punts      = []string{"PUNT", "BLOCKED PUNT", "PUNT TD", "BLOCKED PUNT TD"}
+)
+
+const (
+	// threeAndOutPlays is the most plays of a three-and-out, counting the
+	// punt.
+	threeAndOutPlays = 4
+	// opportunityYards is the yards to go within which a drive has a
+	// scoring opportunity.
+	opportunityYards = 40
+	// oneScore is the largest margin one score can make up.
+	oneScore = 8
+	// overtime indexes overtime among the quarters.
+	overtime = clock.RegulationPeriods
+)
+
+// Points returns the offense's points on d: the change in its score, or,
+// when that is missing, the value of the drive's result.
+func Points(d *cfbd.Drive) int32 {
+	if !d.GetScoring() {
+		return 0
+	}
+	if change := d.GetEndOffenseScore() - d.GetStartOffenseScore(); change > 0 {
+		return change
+	}
+	switch {
+	case slices.Contains(touchdowns, d.GetDriveResult()):
+		return Touchdown
+	case slices.Contains(fieldGoals, d.GetDriveResult()):
+		return FieldGoal
+	default:
+		return 0
+	}
+}
+
+// ThreeAndOut reports whether d was punted away after three plays or
+// fewer.
+func ThreeAndOut(d *cfbd.Drive) bool {
+	return d.GetPlays() <= threeAndOutPlays &&
+		slices.Contains(punts, d.GetDriveResult())
+}
+
+// ScoringOpportunity reports whether d reached the opponent's 40 or
+// scored.
+func ScoringOpportunity(d *cfbd.Drive) bool {
+	return Points(d) > 0 ||
+		d.GetStartYardsToGoal() <= opportunityYards ||
+		d.GetEndYardsToGoal() <= opportunityYards
+}
+
+// Position is a range of starting field position.
+type Position int
+
+const (
+	// OwnDeep is inside the offense's own 20.
+	OwnDeep Position = iota
+	// Own is from the offense's own 20 to its 39.
+	Own
+	// Midfield is from the offense's own 40 to the opponent's 40.
+	Midfield
+	// Opponent is from the opponent's 39 to its 21.
+	Opponent
+	// RedZone is the opponent's 20 and in.
+	RedZone
+
+	numPositions
+)
+
+// Yards to go at the start of each Position, deepest first.
+const (
+	ownDeepStart  = 81
+	ownStart      = 61
+	midfieldStart = 40
+	redZoneStart  = 20
+)
+
+// PositionOf returns the position of a drive starting yardsToGoal from the
+// opponent's goal line.
+func PositionOf(yardsToGoal int32) Position {
+	switch {
+	case yardsToGoal >= ownDeepStart:
+		return OwnDeep
+	case yardsToGoal >= ownStart:
+		return Own
+	case yardsToGoal >= midfieldStart:
+		return Midfield
+	case yardsToGoal > redZoneStart:
+		return Opponent
+	default:
+		return RedZone
+	}
+}
+
+func (p Position) String() string {
+	switch p {
+	case OwnDeep:
+		return "own deep"
+	case Own:
+		return "own"
+	case Midfield:
+		return "midfield"
+	case Opponent:
+		return "opponent"
+	case RedZone:
+		return "red zone"
+	default:
+		return fmt.Sprintf("Position(%d)", int(p))
+	}
+}
+
+// State is the score at the start of a drive, from the team's perspective.
+type State int
+
+const (
+	// TrailingBig is trailing by more than one score.
+	TrailingBig State = iota
+	// Trailing is trailing by one score.
+	Trailing
+	// Tied is a tied game.
+	Tied
+	// Leading is leading by one score.
+	Leading
+	// LeadingBig is leading by more than one score.
+	LeadingBig
+
+	numStates
+)
+
+// StateOf returns the state of a team ahead by margin, which is negative
+// when it trails.
+func StateOf(margin int32) State {
+	switch {
+	case margin < -oneScore:
+		return TrailingBig
+	case margin < 0:
+		return Trailing
+	case margin == 0:
+		return Tied
+	case margin <= oneScore:
+		return Leading
+	default:
+		return LeadingBig
+	}
+}
+
+func (s State) String() string {
+	switch s {
+	case TrailingBig:
+		return "trailing big"
+	case Trailing:
+		return "trailing"
+	case Tied:
+		return "tied"
+	case Leading:
+		return "leading"
+	case LeadingBig:
+		return "leading big"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
+// Metrics accumulates a set of drives.
+type Metrics struct {
+	Drives int
+	Points int
+	// AdjustedPoints are the points adjusted for the opponents; without
+	// ratings they equal Points.
+	AdjustedPoints float64
+	Plays          int
+	// Yards counts drive yards up to the yards available, and
+	// AvailableYards the yards to go at the start of each drive.
+	Yards                int
+	AvailableYards       int
+	ThreeAndOuts         int
+	ScoringOpportunities int
+	// OpportunityPoints are the points of drives with a scoring
+	// opportunity.
+	OpportunityPoints int
+	// Starts counts the drives starting at each Position.
+	Starts [numPositions]int
+	// Elapsed is the time of drives with a clock, and TimedPlays their
+	// plays.
+	Elapsed    time.Duration
+	TimedPlays int
+}
+
+// Add counts d, adjusted to adjusted points.
+func (m *Metrics) Add(d *cfbd.Drive, adjusted float64) {
+	points := int(Points(d))
+	m.Drives++
+	m.Points += points
+	m.AdjustedPoints += adjusted
+	m.Plays += int(d.GetPlays())
+	m.Yards += int(min(d.GetYards(), d.GetStartYardsToGoal()))
+	m.AvailableYards += int(d.GetStartYardsToGoal())
+	m.Starts[PositionOf(d.GetStartYardsToGoal())]++
+	if ThreeAndOut(d) {
+		m.ThreeAndOuts++
+	}
+	if ScoringOpportunity(d) {
+		m.ScoringOpportunities++
+		m.OpportunityPoints += points
+	}
+	if elapsed := clock.Int32Duration(d.GetElapsed()); elapsed > 0 &&
+		d.GetPlays() > 0 {
+		m.Elapsed += elapsed
+		m.TimedPlays += int(d.GetPlays())
+	}
+}
+
+// PointsPerDrive returns the points per drive, or 0 without drives.
+func (m Metrics) PointsPerDrive() float64 {
+	return ratio(float64(m.Points), m.Drives)
+}
+
+// AdjustedPointsPerDrive returns the opponent adjusted points per drive.
+func (m Metrics) AdjustedPointsPerDrive() float64 {
+	return ratio(m.AdjustedPoints, m.Drives)
+}
+
+// AvailableYardsRate returns the share of the yards available that drives
+// gained.
+func (m Metrics) AvailableYardsRate() float64 {
+	return ratio(float64(m.Yards), m.AvailableYards)
+}
+
+// ThreeAndOutRate returns the share of drives that were three-and-outs.
+func (m Metrics) ThreeAndOutRate() float64 {
+	return ratio(float64(m.ThreeAndOuts), m.Drives)
+}
+
+// ScoringOpportunityRate returns the share of drives with a scoring
+// opportunity.
+func (m Metrics) ScoringOpportunityRate() float64 {
+	return ratio(float64(m.ScoringOpportunities), m.Drives)
+}
+
+// PointsPerOpportunity returns the points per scoring opportunity.
+func (m Metrics) PointsPerOpportunity() float64 {
+	return ratio(float64(m.OpportunityPoints), m.ScoringOpportunities)
+}
+
+// StartShare returns the share of drives starting at p.
+func (m Metrics) StartShare(p Position) float64 {
+	if p < 0 || p >= numPositions {
+		return 0
+	}
+
+	return ratio(float64(m.Starts[p]), m.Drives)
+}
+
+// AverageStart returns the mean yards to go at the start of drives.
+func (m Metrics) AverageStart() float64 {
+	return ratio(float64(m.AvailableYards), m.Drives)
+}
+
+// SecondsPerPlay returns the tempo of drives with a clock.
+func (m Metrics) SecondsPerPlay() float64 {
+	return ratio(m.Elapsed.Seconds(), m.TimedPlays)
+}
+
+// Breakdown is a team's drives on one side of the ball, overall, by
+// quarter and by game state.
+type Breakdown struct {
+	All Metrics
+	// Quarters holds the four quarters, first quarter first, then
+	// overtime.
+	Quarters [clock.RegulationPeriods + 1]Metrics
+	States   [numStates]Metrics
+}
+
+// Quarter returns the drives starting in period, with every overtime
+// period together.
+func (b Breakdown) Quarter(period int32) Metrics {
+	if period < 1 {
+		return Metrics{}
+	}
+
+	return b.Quarters[min(int(period)-1, overtime)]
+}
+
+// State returns the drives starting in s.
+func (b Breakdown) State(s State) Metrics {
+	if s < 0 || s >= numStates {
+		return Metrics{}
+	}
+
+	return b.States[s]
+}
+
+func (b *Breakdown) add(d *cfbd.Drive, margin int32, adjusted float64) {
+	b.All.Add(d, adjusted)
+	if period := d.GetStartPeriod(); period >= 1 {
+		b.Quarters[min(int(period)-1, overtime)].Add(d, adjusted)
+	}
+	b.States[StateOf(margin)].Add(d, adjusted)
+}
+
+func ratio(num float64, den int) float64 {
+	if den == 0 {
+		return 0
+	}
+
+	return num / float64(den)
+}
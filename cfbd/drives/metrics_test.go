@@ -0,0 +1,104 @@
+package drives
+
+import (
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoints_ShouldPreferScoreChange(t *testing.T) {
+	td := drive("Texas", "Rice", 1, 75, "TD", 5, 60)
+	td.Scoring, td.EndOffenseScore = true, 6
+
+	assert.Equal(t, int32(6), Points(td))
+
+	td.EndOffenseScore = 0
+	assert.Equal(t, int32(Touchdown), Points(td))
+
+	fg := drive("Texas", "Rice", 1, 75, "FG", 8, 50)
+	fg.Scoring = true
+	assert.Equal(t, int32(FieldGoal), Points(fg))
+
+	pick := drive("Texas", "Rice", 1, 75, "INT TD", 3, 10)
+	pick.Scoring = true
+	assert.Zero(t, Points(pick))
+}
+
+func TestThreeAndOut_ShouldCountPuntsAfterThreePlays(t *testing.T) {
+	assert.True(t, ThreeAndOut(drive("A", "B", 1, 75, "PUNT", 4, 2)))
+	assert.False(t, ThreeAndOut(drive("A", "B", 1, 75, "PUNT", 5, 12)))
+	assert.False(t, ThreeAndOut(drive("A", "B", 1, 75, "INT", 2, 5)))
+}
+
+func TestScoringOpportunity_ShouldNeedOpponentForty(t *testing.T) {
+	reached := drive("A", "B", 1, 75, "PUNT", 8, 36)
+	reached.EndYardsToGoal = 39
+	short := drive("A", "B", 1, 75, "PUNT", 6, 30)
+	short.EndYardsToGoal = 45
+
+	assert.True(t, ScoringOpportunity(reached))
+	assert.False(t, ScoringOpportunity(short))
+	assert.True(t, ScoringOpportunity(drive("A", "B", 1, 35, "INT", 1, 0)))
+}
+
+func TestPositionOf_ShouldBucketYardsToGoal(t *testing.T) {
+	cases := map[int32]Position{
+		99: OwnDeep, 81: OwnDeep, 80: Own, 61: Own, 60: Midfield,
+		40: Midfield, 39: Opponent, 21: Opponent, 20: RedZone, 1: RedZone,
+	}
+	for ytg, want := range cases {
+		assert.Equal(t, want, PositionOf(ytg), ytg)
+	}
+	assert.Equal(t, "red zone", RedZone.String())
+	assert.Equal(t, "Position(9)", Position(9).String())
+}
+
+func TestStateOf_ShouldSplitByOneScore(t *testing.T) {
+	cases := map[int32]State{
+		-9: TrailingBig, -8: Trailing, -1: Trailing, 0: Tied,
+		1: Leading, 8: Leading, 9: LeadingBig,
+	}
+	for margin, want := range cases {
+		assert.Equal(t, want, StateOf(margin), margin)
+	}
+	assert.Equal(t, "trailing big", TrailingBig.String())
+	assert.Equal(t, "State(-1)", State(-1).String())
+}
+
+func TestMetrics_ShouldComputeRates(t *testing.T) {
+	var m Metrics
+	td := drive("A", "B", 1, 60, "TD", 6, 60)
+	td.Scoring, td.EndOffenseScore = true, 7
+	td.Elapsed = &cfbd.ClockInt32{Minutes: ptr[int32](2), Seconds: ptr[int32](0)}
+	punt := drive("A", "B", 1, 90, "PUNT", 4, 1)
+	// Penalties can take a drive past the yards available.
+	long := drive("A", "B", 1, 30, "FG", 5, 35)
+	long.Scoring, long.EndOffenseScore = true, 3
+
+	m.Add(td, 7)
+	m.Add(punt, 0)
+	m.Add(long, 3)
+
+	assert.Equal(t, 3, m.Drives)
+	assert.InDelta(t, 10.0/3, m.PointsPerDrive(), 1e-9)
+	assert.InDelta(t, 91.0/180, m.AvailableYardsRate(), 1e-9)
+	assert.InDelta(t, 1.0/3, m.ThreeAndOutRate(), 1e-9)
+	assert.InDelta(t, 2.0/3, m.ScoringOpportunityRate(), 1e-9)
+	assert.InDelta(t, 5.0, m.PointsPerOpportunity(), 1e-9)
+	assert.InDelta(t, 60.0, m.AverageStart(), 1e-9)
+	assert.InDelta(t, 1.0/3, m.StartShare(OwnDeep), 1e-9)
+	assert.InDelta(t, 1.0/3, m.StartShare(Midfield), 1e-9)
+	assert.Zero(t, m.StartShare(RedZone))
+	assert.Zero(t, m.StartShare(Position(-1)))
+	// Only the touchdown drive has a clock.
+	assert.InDelta(t, 20.0, m.SecondsPerPlay(), 1e-9)
+}
+
+func TestMetrics_Empty_ShouldBeZero(t *testing.T) {
+	var m Metrics
+
+	assert.Zero(t, m.PointsPerDrive())
+	assert.Zero(t, m.AvailableYardsRate())
+	assert.Zero(t, m.SecondsPerPlay())
+}
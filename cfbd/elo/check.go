@@ -0,0 +1,77 @@
+package elo
+
+import (
+	"math"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// Diff is the difference between a replayed rating and the one reported
+// on Game, replayed minus reported.
+type Diff struct {
+	GameID   int32
+	Team     string
+	Pregame  float64
+	Postgame float64
+}
+
+// Comparison summarizes how closely replayed results match the Elo values
+// reported on games.
+type Comparison struct {
+	// Diffs holds one entry per team and game with reported values.
+	Diffs []Diff
+	// PregameMAE and PostgameMAE are the mean absolute differences.
+	PregameMAE  float64
+	PostgameMAE float64
+	// MaxAbs is the largest absolute difference of either kind.
+	MaxAbs float64
+}
+
+// Compare matches results to games by ID and compares replayed ratings
+// with the pregame and postgame Elo reported on each game. Sides without
+// both reported values are left out.
+func Compare(games []*cfbd.Game, results []Result) Comparison {
+	byID := make(map[int32]*cfbd.Game, len(games))
+	for _, g := range games {
+		byID[g.GetId()] = g
+	}
+
+	var c Comparison
+	for _, r := range results {
+		g, ok := byID[r.GameID]
+		if !ok {
+			continue
+		}
+		c.add(r.GameID, r.Home, r.HomePregame, r.HomePostgame,
+			g.HomePregameElo, g.HomePostgameElo)
+		c.add(r.GameID, r.Away, r.AwayPregame, r.AwayPostgame,
+			g.AwayPregameElo, g.AwayPostgameElo)
+	}
+
+	if n := float64(len(c.Diffs)); n > 0 {
+		c.PregameMAE /= n
+		c.PostgameMAE /= n
+	}
+
+	return c
+}
+
+func (c *Comparison) add(
+	gameID int32, team string, pregame, postgame float64,
+	wantPregame, wantPostgame *int32,
+) {
+	if wantPregame == nil || wantPostgame == nil {
+		return
+	}
+
+	d := Diff{
+		GameID:   gameID,
+		Team:     team,
+		Pregame:  pregame - float64(*wantPregame),
+		Postgame: postgame - float64(*wantPostgame),
+	}
+	c.Diffs = append(c.Diffs, d)
+	c.PregameMAE += math.Abs(d.Pregame)
+	c.PostgameMAE += math.Abs(d.Postgame)
+	c.MaxAbs = max(c.MaxAbs, math.Abs(d.Pregame), math.Abs(d.Postgame))
+}
@@ -0,0 +1,387 @@
+// Package elo replays game results through an Elo rating system, so that
+// the ratings published by GetEloRatings and the pregame and postgame Elo
+// values on Game can be reproduced, checked and projected forward.
+package elo
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// ClassificationFBS is the classification of FBS teams on Game. Teams of
+// any other classification are handled as FCS teams.
+const ClassificationFBS = "fbs"
+
+// MarginFunc scales a rating change by the margin of victory. diff is the
+// winner's pregame rating minus the loser's, home field included, so that
+// favorites gain less from a blowout than underdogs do.
+type MarginFunc func(margin int, diff float64) float64
+
+// FlatMargin ignores the margin of victory.
+func FlatMargin(int, float64) float64 {
+	return 1
+}
+
+// LogMargin is the multiplier popularized by FiveThirtyEight:
+// ln(margin+1) * 2.2 / (0.001*diff + 2.2). Ties count as one point.
+func LogMargin(margin int, diff float64) float64 {
+	const autocorrelation = 2.2
+
+	return math.Log(float64(max(margin, 1))+1) * autocorrelation /
+		(diff/1000 + autocorrelation)
+}
+
+// Option configures an Engine.
+type Option func(*Engine)
+
+// WithKFactor sets how far a single result moves ratings. The default is
+// 20.
+func WithKFactor(k float64) Option {
+	return func(e *Engine) {
+		e.k = k
+	}
+}
+
+// WithHomeField sets the rating points added to the home team outside
+// neutral site games. The default is 55.
+func WithHomeField(points float64) Option {
+	return func(e *Engine) {
+		e.homeField = points
+	}
+}
+
+// WithMarginOfVictory sets the margin of victory multiplier. The default
+// is LogMargin; FlatMargin disables it.
+func WithMarginOfVictory(f MarginFunc) Option {
+	return func(e *Engine) {
+		e.margin = f
+	}
+}
+
+// WithMean sets the rating new FBS teams start at and that ratings regress
+// toward between seasons. The default is 1500.
+func WithMean(mean float64) Option {
+	return func(e *Engine) {
+		e.mean = mean
+	}
+}
+
+// WithRegression sets the fraction of the distance to the mean every
+// rating loses between seasons. The default is one third; 0 carries
+// ratings over unchanged.
+func WithRegression(fraction float64) Option {
+	return func(e *Engine) {
+		e.regression = fraction
+	}
+}
+
+// WithFCSRating sets the rating non-FBS teams start at. The default is
+// 1200.
+func WithFCSRating(rating float64) Option {
+	return func(e *Engine) {
+		e.fcsRating = rating
+	}
+}
+
+// WithFixedFCS keeps non-FBS teams at the FCS rating instead of updating
+// them, which suits data sets that only hold their games against FBS
+// opponents.
+func WithFixedFCS() Option {
+	return func(e *Engine) {
+		e.fixedFCS = true
+	}
+}
+
+// WithRatings seeds ratings, for example from GetEloRatings for the season
+// before the games being replayed.
+func WithRatings(ratings []*cfbd.TeamElo) Option {
+	return func(e *Engine) {
+		for _, r := range ratings {
+			if r.Elo != nil {
+				e.ratings[r.GetTeam()] = float64(r.GetElo())
+				e.conferences[r.GetTeam()] = r.GetConference()
+				e.season = max(e.season, r.GetYear())
+			}
+		}
+	}
+}
+
+// WithPregameSeed starts each team at the pregame Elo reported on its first
+// game, when there is one, instead of at the mean.
+func WithPregameSeed() Option {
+	return func(e *Engine) {
+		e.pregameSeed = true
+	}
+}
+
+// Result is the outcome of replaying one game.
+type Result struct {
+	GameID int32
+	Season int32
+	Home   string
+	Away   string
+	// HomeWinProbability is the expected score of the home team before the
+	// game.
+	HomeWinProbability float64
+	HomePregame        float64
+	HomePostgame       float64
+	AwayPregame        float64
+	AwayPostgame       float64
+}
+
+// Projection is the forecast of an upcoming game.
+type Projection struct {
+	GameID             int32
+	Home               string
+	Away               string
+	HomeRating         float64
+	AwayRating         float64
+	HomeWinProbability float64
+}
+
+// Engine holds ratings by team name. It is not safe for concurrent use.
+type Engine struct {
+	k           float64
+	homeField   float64
+	margin      MarginFunc
+	mean        float64
+	regression  float64
+	fcsRating   float64
+	fixedFCS    bool
+	pregameSeed bool
+
+	season      int32
+	ratings     map[string]float64
+	conferences map[string]string
+}
+
+// New returns an engine with no games played.
+func New(opts ...Option) *Engine {
+	e := &Engine{
+		k:           20,
+		homeField:   55,
+		margin:      LogMargin,
+		mean:        1500,
+		regression:  1.0 / 3,
+		fcsRating:   1200,
+		ratings:     make(map[string]float64),
+		conferences: make(map[string]string),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+// Replay plays completed games in chronological order and returns their
+// results in that order. Games without final scores are skipped.
+func (e *Engine) Replay(games []*cfbd.Game) []Result {
+	var results []Result
+	for _, g := range Chronological(games) {
+		if r, ok := e.Play(g); ok {
+			results = append(results, r)
+		}
+	}
+
+	return results
+}
+
+// Play updates ratings with a single completed game. ok is false when the
+// game has no final score. Games must be played in chronological order;
+// the first game of a new season regresses every rating toward the mean.
+func (e *Engine) Play(g *cfbd.Game) (Result, bool) {
+	if !g.GetCompleted() || g.HomePoints == nil || g.AwayPoints == nil {
+		return Result{}, false
+	}
+	e.startSeason(g.GetSeason())
+
+	home := e.rating(g.GetHomeTeam(), g.GetHomeClassification(),
+		g.HomePregameElo)
+	away := e.rating(g.GetAwayTeam(), g.GetAwayClassification(),
+		g.AwayPregameElo)
+	e.conferences[g.GetHomeTeam()] = g.GetHomeConference()
+	e.conferences[g.GetAwayTeam()] = g.GetAwayConference()
+
+	r := Result{
+		GameID:             g.GetId(),
+		Season:             g.GetSeason(),
+		Home:               g.GetHomeTeam(),
+		Away:               g.GetAwayTeam(),
+		HomeWinProbability: e.expected(home, away, g.GetNeutralSite()),
+		HomePregame:        home,
+		AwayPregame:        away,
+	}
+
+	shift := e.shift(home, away, g)
+	r.HomePostgame = e.update(g.GetHomeTeam(), g.GetHomeClassification(),
+		home+shift)
+	r.AwayPostgame = e.update(g.GetAwayTeam(), g.GetAwayClassification(),
+		away-shift)
+
+	return r, true
+}
+
+// shift returns the rating points the home team gains from g.
+func (e *Engine) shift(home, away float64, g *cfbd.Game) float64 {
+	expected := e.expected(home, away, g.GetNeutralSite())
+	margin := int(g.GetHomePoints() - g.GetAwayPoints())
+
+	actual := 0.5
+	diff := e.diff(home, away, g.GetNeutralSite())
+	switch {
+	case margin > 0:
+		actual = 1
+	case margin < 0:
+		actual = 0
+		margin, diff = -margin, -diff
+	}
+
+	return e.k * e.margin(margin, diff) * (actual - expected)
+}
+
+// WinProbability returns the probability that home beats away with the
+// current ratings.
+func (e *Engine) WinProbability(home, away string, neutral bool) float64 {
+	return e.expected(e.Rating(home), e.Rating(away), neutral)
+}
+
+// Project forecasts every game not yet completed with the current
+// ratings, in chronological order.
+func (e *Engine) Project(games []*cfbd.Game) []Projection {
+	var out []Projection
+	for _, g := range Chronological(games) {
+		if g.GetCompleted() {
+			continue
+		}
+		home := e.rating(g.GetHomeTeam(), g.GetHomeClassification(), nil)
+		away := e.rating(g.GetAwayTeam(), g.GetAwayClassification(), nil)
+		out = append(out, Projection{
+			GameID:             g.GetId(),
+			Home:               g.GetHomeTeam(),
+			Away:               g.GetAwayTeam(),
+			HomeRating:         home,
+			AwayRating:         away,
+			HomeWinProbability: e.expected(home, away, g.GetNeutralSite()),
+		})
+	}
+
+	return out
+}
+
+// Rating returns the current rating of team, or the mean for a team that
+// has not played.
+func (e *Engine) Rating(team string) float64 {
+	if r, ok := e.ratings[team]; ok {
+		return r
+	}
+
+	return e.mean
+}
+
+// Ratings returns the current ratings in the shape of GetEloRatings,
+// highest first.
+func (e *Engine) Ratings() []*cfbd.TeamElo {
+	out := make([]*cfbd.TeamElo, 0, len(e.ratings))
+	for team, r := range e.ratings {
+		elo := int32(math.Round(r))
+		out = append(out, &cfbd.TeamElo{
+			Year:       e.season,
+			Team:       team,
+			Conference: e.conferences[team],
+			Elo:        &elo,
+		})
+	}
+	slices.SortFunc(out, func(a, b *cfbd.TeamElo) int {
+		return cmp.Or(
+			cmp.Compare(b.GetElo(), a.GetElo()),
+			cmp.Compare(a.GetTeam(), b.GetTeam()),
+		)
+	})
+
+	return out
+}
+
+func (e *Engine) startSeason(season int32) {
+	if season <= e.season {
+		return
+	}
+	if e.season != 0 {
+		for team, r := range e.ratings {
+			e.ratings[team] = r - e.regression*(r-e.mean)
+		}
+	}
+	e.season = season
+}
+
+// rating returns the pregame rating of team, seeding teams seen for the
+// first time.
+func (e *Engine) rating(
+	team, classification string, pregame *int32,
+) float64 {
+	if e.fcs(classification) && e.fixedFCS {
+		return e.fcsRating
+	}
+	if r, ok := e.ratings[team]; ok {
+		return r
+	}
+
+	switch {
+	case e.pregameSeed && pregame != nil:
+		return float64(*pregame)
+	case e.fcs(classification):
+		return e.fcsRating
+	default:
+		return e.mean
+	}
+}
+
+func (e *Engine) update(team, classification string, r float64) float64 {
+	if e.fcs(classification) && e.fixedFCS {
+		return e.fcsRating
+	}
+	e.ratings[team] = r
+
+	return r
+}
+
+func (e *Engine) fcs(classification string) bool {
+	return classification != "" && classification != ClassificationFBS
+}
+
+func (e *Engine) diff(home, away float64, neutral bool) float64 {
+	if neutral {
+		return home - away
+	}
+
+	return home + e.homeField - away
+}
+
+func (e *Engine) expected(home, away float64, neutral bool) float64 {
+	return Expected(e.diff(home, away, neutral))
+}
+
+// Expected returns the expected score of a team rated diff points above
+// its opponent.
+func Expected(diff float64) float64 {
+	const scale = 400
+
+	return 1 / (1 + math.Pow(10, -diff/scale))
+}
+
+// Chronological returns games ordered by season, start date and ID.
+func Chronological(games []*cfbd.Game) []*cfbd.Game {
+	out := slices.Clone(games)
+	slices.SortStableFunc(out, func(a, b *cfbd.Game) int {
+		return cmp.Or(
+			cmp.Compare(a.GetSeason(), b.GetSeason()),
+			a.GetStartDate().AsTime().Compare(b.GetStartDate().AsTime()),
+			cmp.Compare(a.GetId(), b.GetId()),
+		)
+	})
+
+	return out
+}
@@ -0,0 +1,136 @@
+package elo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func loadGames(t *testing.T) []*cfbd.Game {
+	return fixture.Load(t, "games.json", func() *cfbd.Game {
+		return &cfbd.Game{}
+	})
+}
+
+func game(
+	id, season int32, day int, home, away string, homePts, awayPts int32,
+) *cfbd.Game {
+	start := time.Date(int(season), 9, day, 16, 0, 0, 0, time.UTC)
+
+	return &cfbd.Game{
+		Id:                 id,
+		Season:             season,
+		StartDate:          timestamppb.New(start),
+		Completed:          true,
+		HomeTeam:           home,
+		HomeClassification: ClassificationFBS,
+		HomePoints:         &homePts,
+		AwayTeam:           away,
+		AwayClassification: ClassificationFBS,
+		AwayPoints:         &awayPts,
+	}
+}
+
+func TestReplay_FixtureWithPregameSeed_ShouldMatchReportedElo(t *testing.T) {
+	games := loadGames(t)
+	e := New(WithPregameSeed(), WithKFactor(5.6))
+
+	results := e.Replay(games)
+	c := Compare(games, results)
+
+	require.Len(t, results, 1)
+	require.Len(t, c.Diffs, 2)
+	assert.Zero(t, c.PregameMAE)
+	assert.Less(t, c.PostgameMAE, 0.5)
+	assert.Less(t, c.MaxAbs, 0.5)
+	assert.InDelta(t, 0.725, results[0].HomeWinProbability, 0.001)
+}
+
+func TestPlay_Upset_ShouldMoveRatingsMoreThanExpectedWin(t *testing.T) {
+	favorite := New()
+	favorite.Play(game(1, 2025, 6, "A", "B", 28, 21))
+	upset := New()
+	upset.Play(game(1, 2025, 6, "A", "B", 21, 28))
+
+	gain := favorite.Rating("A") - 1500
+	loss := 1500 - upset.Rating("A")
+	assert.Positive(t, gain)
+	assert.Greater(t, loss, gain)
+	assert.InDelta(t, 3000, upset.Rating("A")+upset.Rating("B"), 1e-9)
+}
+
+func TestReplay_Unordered_ShouldPlayChronologically(t *testing.T) {
+	games := []*cfbd.Game{
+		game(2, 2025, 13, "A", "C", 10, 7),
+		game(1, 2025, 6, "A", "B", 10, 7),
+		{Id: 3, Season: 2025, HomeTeam: "A", AwayTeam: "B"},
+	}
+
+	results := New().Replay(games)
+
+	require.Len(t, results, 2)
+	assert.Equal(t, int32(1), results[0].GameID)
+	assert.InDelta(t, results[0].HomePostgame, results[1].HomePregame, 1e-9)
+}
+
+func TestPlay_NewSeason_ShouldRegressToMean(t *testing.T) {
+	e := New(WithRatings([]*cfbd.TeamElo{
+		{Year: 2024, Team: "A", Elo: proto.Int32(1800)},
+		{Year: 2024, Team: "B", Elo: proto.Int32(1500)},
+	}), WithRegression(0.5))
+
+	r, ok := e.Play(game(1, 2025, 6, "A", "B", 10, 7))
+
+	require.True(t, ok)
+	assert.InDelta(t, 1650, r.HomePregame, 1e-9)
+	assert.InDelta(t, 1500, r.AwayPregame, 1e-9)
+}
+
+func TestPlay_FixedFCS_ShouldNotUpdateFCSTeam(t *testing.T) {
+	g := game(1, 2025, 6, "A", "Montana", 3, 7)
+	g.AwayClassification = "fcs"
+	e := New(WithFixedFCS(), WithFCSRating(1250))
+
+	r, ok := e.Play(g)
+
+	require.True(t, ok)
+	assert.InDelta(t, 1250, r.AwayPregame, 1e-9)
+	assert.InDelta(t, 1250, r.AwayPostgame, 1e-9)
+	assert.Less(t, r.HomePostgame, 1500.0)
+	assert.Len(t, e.Ratings(), 1)
+}
+
+func TestPlay_FlatMargin_ShouldIgnoreScore(t *testing.T) {
+	narrow := New(WithMarginOfVictory(FlatMargin), WithHomeField(0))
+	narrow.Play(game(1, 2025, 6, "A", "B", 8, 7))
+	blowout := New(WithMarginOfVictory(FlatMargin), WithHomeField(0))
+	blowout.Play(game(1, 2025, 6, "A", "B", 63, 7))
+
+	assert.InDelta(t, 1510, narrow.Rating("A"), 1e-9)
+	assert.InDelta(t, narrow.Rating("A"), blowout.Rating("A"), 1e-9)
+}
+
+func TestProject_ShouldForecastIncompleteGames(t *testing.T) {
+	e := New(WithRatings([]*cfbd.TeamElo{
+		{Year: 2025, Team: "A", Conference: "SEC", Elo: proto.Int32(1700)},
+		{Year: 2025, Team: "B", Elo: proto.Int32(1500)},
+	}))
+	upcoming := &cfbd.Game{Id: 9, HomeTeam: "B", AwayTeam: "A",
+		NeutralSite: true}
+
+	p := e.Project([]*cfbd.Game{game(1, 2025, 6, "A", "B", 10, 7), upcoming})
+
+	require.Len(t, p, 1)
+	assert.InDelta(t, Expected(-200), p[0].HomeWinProbability, 1e-9)
+	assert.InDelta(t, Expected(255), e.WinProbability("A", "B", false), 1e-9)
+	ratings := e.Ratings()
+	assert.Equal(t, "A", ratings[0].GetTeam())
+	assert.Equal(t, "SEC", ratings[0].GetConference())
+	assert.Equal(t, int32(1700), ratings[0].GetElo())
+}
@@ -0,0 +1,307 @@
+package epa
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// Line yards credit, by yards gained on a rush: losses count for
+// lossCredit of their yards, the first lineFull yards in full, yards up to
+// secondLevelEnd at half and longer gains not at all. Yards beyond
+// lineFull up to secondLevelEnd are second level yards and yards beyond
+// secondLevelEnd open field yards.
+const (
+	lossCredit      = 1.2
+	lineFull        = 4
+	secondLevelEnd  = 10
+	secondLevelPart = 0.5
+	// powerDistance is the most yards to go of a power situation.
+	powerDistance = 2
+)
+
+// Stats accumulates the values of a set of plays.
+type Stats struct {
+	Plays     int
+	Successes int
+	TotalEPA  float64
+	// SuccessEPA is the total EPA of successful plays.
+	SuccessEPA float64
+}
+
+// Add counts v.
+func (s *Stats) Add(v Value) {
+	s.Plays++
+	s.TotalEPA += v.EPA
+	if v.Success {
+		s.Successes++
+		s.SuccessEPA += v.EPA
+	}
+}
+
+// EPA returns the EPA per play, or 0 without plays.
+func (s Stats) EPA() float64 {
+	return ratio(s.TotalEPA, s.Plays)
+}
+
+// SuccessRate returns the share of successful plays.
+func (s Stats) SuccessRate() float64 {
+	return ratio(float64(s.Successes), s.Plays)
+}
+
+// Explosiveness returns the EPA per successful play.
+func (s Stats) Explosiveness() float64 {
+	return ratio(s.SuccessEPA, s.Successes)
+}
+
+// Blocking accumulates the line metrics of rushes.
+type Blocking struct {
+	Rushes           int
+	LineYards        float64
+	SecondLevelYards int
+	OpenFieldYards   int
+	// Stuffs counts rushes for no gain or a loss.
+	Stuffs int
+	// PowerRushes are rushes on third or fourth down, or at the goal line,
+	// with two yards or less to go, and PowerSuccesses those that gained a
+	// first down or touchdown.
+	PowerRushes    int
+	PowerSuccesses int
+}
+
+func (r *Blocking) add(p *cfbd.Play) {
+	yards := p.GetYardsGained()
+	r.Rushes++
+	switch {
+	case yards < 0:
+		r.LineYards += lossCredit * float64(yards)
+	case yards <= lineFull:
+		r.LineYards += float64(yards)
+	default:
+		r.LineYards += lineFull +
+			secondLevelPart*float64(min(yards, secondLevelEnd)-lineFull)
+	}
+	if yards <= 0 {
+		r.Stuffs++
+	}
+	r.SecondLevelYards += int(min(max(yards-lineFull, 0),
+		secondLevelEnd-lineFull))
+	r.OpenFieldYards += int(max(yards-secondLevelEnd, 0))
+
+	if p.GetDistance() <= powerDistance &&
+		(p.GetDown() >= 3 || p.GetYardsToGoal() <= powerDistance) {
+		r.PowerRushes++
+		if p.GetYardsGained() >= p.GetDistance() || p.GetScoring() {
+			r.PowerSuccesses++
+		}
+	}
+}
+
+// Side is a team's plays on one side of the ball in a game.
+type Side struct {
+	All           Stats
+	Rushing       Stats
+	Passing       Stats
+	StandardDowns Stats
+	PassingDowns  Stats
+	FirstDown     Stats
+	SecondDown    Stats
+	ThirdDown     Stats
+	Blocking      Blocking
+	Drives        int
+
+	drives map[string]bool
+}
+
+func (s *Side) add(v Value) {
+	s.All.Add(v)
+	if v.Kind == Rush {
+		s.Rushing.Add(v)
+		s.Blocking.add(v.Play)
+	} else {
+		s.Passing.Add(v)
+	}
+	if v.PassingDown {
+		s.PassingDowns.Add(v)
+	} else {
+		s.StandardDowns.Add(v)
+	}
+	switch v.Play.GetDown() {
+	case 1:
+		s.FirstDown.Add(v)
+	case 2:
+		s.SecondDown.Add(v)
+	case 3:
+		s.ThirdDown.Add(v)
+	}
+
+	if s.drives == nil {
+		s.drives = make(map[string]bool)
+	}
+	if !s.drives[v.Play.GetDriveId()] {
+		s.drives[v.Play.GetDriveId()] = true
+		s.Drives++
+	}
+}
+
+// TeamGame is one team's plays in one game, on offense and on defense.
+type TeamGame struct {
+	GameID     int32
+	Team       string
+	Conference string
+	Opponent   string
+	Offense    Side
+	Defense    Side
+}
+
+// Aggregate sums values by game and team, ordered by game and team.
+func Aggregate(values []Value) []TeamGame {
+	type key struct {
+		game int32
+		team string
+	}
+	games := make(map[key]*TeamGame)
+	get := func(p *cfbd.Play, team, conference, opponent string) *TeamGame {
+		k := key{p.GetGameId(), team}
+		g, ok := games[k]
+		if !ok {
+			g = &TeamGame{
+				GameID:     k.game,
+				Team:       team,
+				Conference: conference,
+				Opponent:   opponent,
+			}
+			games[k] = g
+		}
+		return g
+	}
+
+	for _, v := range values {
+		p := v.Play
+		get(p, p.GetOffense(), p.GetOffenseConference(), p.GetDefense()).
+			Offense.add(v)
+		get(p, p.GetDefense(), p.GetDefenseConference(), p.GetOffense()).
+			Defense.add(v)
+	}
+
+	out := make([]TeamGame, 0, len(games))
+	for _, g := range games {
+		out = append(out, *g)
+	}
+	slices.SortFunc(out, func(a, b TeamGame) int {
+		return cmp.Or(
+			cmp.Compare(a.GameID, b.GameID),
+			cmp.Compare(a.Team, b.Team),
+		)
+	})
+
+	return out
+}
+
+// ExcludeGarbageTime returns the values outside garbage time.
+func ExcludeGarbageTime(values []Value) []Value {
+	var out []Value
+	for _, v := range values {
+		if !v.GarbageTime {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+// PredictedPointsAdded returns the game's EPA per play in the shape of
+// GetPredictedPointsAddedByGame. Defense values are the EPA allowed.
+func (t TeamGame) PredictedPointsAdded() *cfbd.TeamGamePredictedPointsAdded {
+	return &cfbd.TeamGamePredictedPointsAdded{
+		GameId:     t.GameID,
+		Team:       t.Team,
+		Conference: t.Conference,
+		Opponent:   t.Opponent,
+		Offense:    t.Offense.totals(),
+		Defense:    t.Defense.totals(),
+	}
+}
+
+func (s Side) totals() *cfbd.PredictedPointsAddedTotalsForGames {
+	return &cfbd.PredictedPointsAddedTotalsForGames{
+		Overall:    s.All.EPA(),
+		Passing:    s.Passing.EPA(),
+		Rushing:    s.Rushing.EPA(),
+		FirstDown:  s.FirstDown.EPA(),
+		SecondDown: s.SecondDown.EPA(),
+		ThirdDown:  s.ThirdDown.EPA(),
+	}
+}
+
+// AdvancedGameStat returns the game's metrics in the shape of
+// GetAdvancedGameStats. Yard metrics are per rush.
+func (t TeamGame) AdvancedGameStat() *cfbd.AdvancedGameStat {
+	return &cfbd.AdvancedGameStat{
+		GameId:   t.GameID,
+		Team:     t.Team,
+		Opponent: t.Opponent,
+		Offense:  t.Offense.advanced(),
+		Defense:  t.Defense.advanced(),
+	}
+}
+
+func (s Side) advanced() *cfbd.AdvancedGameStatSide {
+	b := s.Blocking
+	perRush := func(v float64) *float64 {
+		return ptr(ratio(v, b.Rushes))
+	}
+
+	return &cfbd.AdvancedGameStatSide{
+		Plays:         ptr(int32(s.All.Plays)),
+		Drives:        ptr(int32(s.Drives)),
+		Ppa:           ptr(s.All.EPA()),
+		Total_PPA:     ptr(s.All.TotalEPA),
+		SuccessRate:   ptr(s.All.SuccessRate()),
+		Explosiveness: ptr(s.All.Explosiveness()),
+		PowerSuccess: ptr(
+			ratio(float64(b.PowerSuccesses), b.PowerRushes)),
+		StuffRate:             perRush(float64(b.Stuffs)),
+		LineYardsTotal:        ptr(int32(math.Round(b.LineYards))),
+		LineYards:             perRush(b.LineYards),
+		SecondLevelYardsTotal: ptr(int32(b.SecondLevelYards)),
+		SecondLevelYards:      perRush(float64(b.SecondLevelYards)),
+		OpenFieldYardsTotal:   ptr(int32(b.OpenFieldYards)),
+		OpenFieldYards:        perRush(float64(b.OpenFieldYards)),
+		StandardDowns:         downs(s.StandardDowns),
+		PassingDowns:          downs(s.PassingDowns),
+		RushingPlays:          plays(s.Rushing),
+		PassingPlays:          plays(s.Passing),
+	}
+}
+
+func downs(s Stats) *cfbd.AdvancedGameStatSideDownMetrics {
+	return &cfbd.AdvancedGameStatSideDownMetrics{
+		Ppa:           ptr(s.EPA()),
+		SuccessRate:   ptr(s.SuccessRate()),
+		Explosiveness: ptr(s.Explosiveness()),
+	}
+}
+
+func plays(s Stats) *cfbd.AdvancedGameStatSidePlayMetrics {
+	return &cfbd.AdvancedGameStatSidePlayMetrics{
+		Ppa:           ptr(s.EPA()),
+		Total_PPA:     ptr(s.TotalEPA),
+		SuccessRate:   ptr(s.SuccessRate()),
+		Explosiveness: ptr(s.Explosiveness()),
+	}
+}
+
+func ratio(num float64, den int) float64 {
+	if den == 0 {
+		return 0
+	}
+
+	return num / float64(den)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,236 @@
+package epa
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// assertNear asserts that got matches the API response want, comparing
+// numbers to within 1e-9.
+func assertNear(t *testing.T, want string, got proto.Message) {
+	t.Helper()
+
+	b, err := protojson.Marshal(got)
+	require.NoError(t, err)
+	var w, g map[string]any
+	require.NoError(t, json.Unmarshal([]byte(want), &w))
+	require.NoError(t, json.Unmarshal(b, &g))
+	near(t, "", w, g)
+}
+
+func near(t *testing.T, path string, want, got any) {
+	t.Helper()
+
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		require.True(t, ok, path)
+		for k, v := range w {
+			near(t, path+"."+k, v, g[k])
+		}
+	case float64:
+		// protojson leaves out zero values of fields without presence.
+		if got == nil {
+			got = 0.0
+		}
+		assert.InDelta(t, w, got, 1e-9, path)
+	default:
+		assert.Equal(t, w, got, path)
+	}
+}
+
+func teamGame(t *testing.T, games []TeamGame, team string) TeamGame {
+	for _, g := range games {
+		if g.Team == team {
+			return g
+		}
+	}
+	require.Fail(t, "missing team", team)
+
+	return TeamGame{}
+}
+
+func TestAggregate_Game_ShouldReproducePredictedPointsAdded(t *testing.T) {
+	games := Aggregate(New(linear()).Plays(game()))
+
+	require.Len(t, games, 2)
+	a := teamGame(t, games, "A")
+	assertNear(t, `{
+		"gameId": 1, "team": "A", "opponent": "B",
+		"offense": {
+			"overall": 0.7916666666666666,
+			"passing": 0.03333333333333333,
+			"rushing": 1.55,
+			"firstDown": -0.5666666666666667,
+			"secondDown": 2.15,
+			"thirdDown": 0
+		},
+		"defense": {
+			"overall": -2.25,
+			"passing": -2.25,
+			"rushing": 0,
+			"firstDown": -2.25
+		}
+	}`, a.PredictedPointsAdded())
+
+	b := teamGame(t, games, "B")
+	assert.InDelta(t, 0.7916666666666666,
+		b.PredictedPointsAdded().GetDefense().GetOverall(), 1e-9)
+}
+
+func TestAggregate_Game_ShouldReproduceAdvancedGameStat(t *testing.T) {
+	a := teamGame(t, Aggregate(New(linear()).Plays(game())), "A")
+
+	assertNear(t, `{
+		"gameId": 1, "team": "A", "opponent": "B",
+		"offense": {
+			"plays": 6,
+			"drives": 2,
+			"ppa": 0.7916666666666666,
+			"totalPPA": 4.75,
+			"successRate": 0.3333333333333333,
+			"explosiveness": 3.775,
+			"powerSuccess": 0,
+			"stuffRate": 0,
+			"lineYards": 4.333333333333333,
+			"lineYardsTotal": 13,
+			"secondLevelYards": 2,
+			"secondLevelYardsTotal": 6,
+			"openFieldYards": 13.666666666666666,
+			"openFieldYardsTotal": 41,
+			"standardDowns": {
+				"ppa": -0.05, "successRate": 0.25, "explosiveness": 1.5
+			},
+			"passingDowns": {
+				"ppa": 2.475, "successRate": 0.5, "explosiveness": 6.05
+			},
+			"rushingPlays": {
+				"ppa": 1.55, "totalPPA": 4.65,
+				"successRate": 0.3333333333333333, "explosiveness": 6.05
+			},
+			"passingPlays": {
+				"ppa": 0.03333333333333333, "totalPPA": 0.1,
+				"successRate": 0.3333333333333333, "explosiveness": 1.5
+			}
+		},
+		"defense": {
+			"plays": 1, "drives": 1, "ppa": -2.25, "totalPPA": -2.25,
+			"successRate": 0
+		}
+	}`, a.AdvancedGameStat())
+}
+
+func TestAggregate_ReportedPPA_ShouldMatchAPIWhenPopulated(t *testing.T) {
+	plays := game()
+	for _, p := range plays {
+		ppa := 0.5
+		p.Ppa = &ppa
+	}
+
+	values := New(linear(), WithReportedPPA()).Plays(plays)
+	a := teamGame(t, Aggregate(values), "A")
+
+	assert.InDelta(t, 0.5, a.PredictedPointsAdded().GetOffense().GetOverall(),
+		1e-9)
+	assert.InDelta(t, 3, a.AdvancedGameStat().GetOffense().GetTotal_PPA(),
+		1e-9)
+}
+
+func TestAggregate_PowerAndStuffs_ShouldCountShortYardageRushes(
+	t *testing.T,
+) {
+	plays := []*cfbd.Play{
+		snap{"1", "d", 1, "A", "B", 3, 1, 40, "Rush", 2}.play(),
+		snap{"2", "d", 1, "A", "B", 1, 2, 2, "Rush", -1}.play(),
+		snap{"3", "d", 1, "A", "B", 2, 3, 3, "Rush", 0}.play(),
+	}
+
+	a := teamGame(t, Aggregate(New(linear()).Plays(plays)), "A")
+
+	assert.Equal(t, 2, a.Offense.Blocking.PowerRushes)
+	assert.Equal(t, 1, a.Offense.Blocking.PowerSuccesses)
+	assert.Equal(t, 2, a.Offense.Blocking.Stuffs)
+	assert.InDelta(t, 0.8, a.Offense.Blocking.LineYards, 1e-9)
+}
+
+func TestExcludeGarbageTime_ShouldDropFlaggedValues(t *testing.T) {
+	values := []Value{
+		{Play: &cfbd.Play{Id: "1"}},
+		{Play: &cfbd.Play{Id: "2"}, GarbageTime: true},
+	}
+
+	got := ExcludeGarbageTime(values)
+
+	require.Len(t, got, 1)
+	assert.Equal(t, "1", got[0].Play.GetId())
+}
+
+// realGame is the game of the stat_game_advanced.json and ppa_games.json
+// fixtures, Texas at Ohio State in 2025, and realPlays its plays from
+// GET /plays.
+const (
+	realGame  = 401752677
+	realPlays = "plays_401752677.json"
+)
+
+func TestAggregate_RealGame_ShouldMatchAPI(t *testing.T) {
+	if _, err := os.Stat(fixture.Path(realPlays)); err != nil {
+		t.Skipf("%s holds no plays of game %d", realPlays, realGame)
+	}
+	plays := fixture.Load(t, realPlays,
+		func() *cfbd.Play { return &cfbd.Play{} })
+	advanced := fixture.Load(t, "stat_game_advanced.json",
+		func() *cfbd.AdvancedGameStat { return &cfbd.AdvancedGameStat{} })[0]
+	ppa := fixture.Load(t, "ppa_games.json",
+		func() *cfbd.TeamGamePredictedPointsAdded {
+			return &cfbd.TeamGamePredictedPointsAdded{}
+		})[0]
+	// The reported PPA sets every EPA, so the curve only has to cover
+	// every down.
+	curve := NewCurve()
+	for down := int32(1); down <= maxDown; down++ {
+		curve.Add(down, 10, loadPredicted(t))
+	}
+
+	games := Aggregate(New(curve, WithReportedPPA()).Plays(plays))
+	texas := teamGame(t, games, advanced.GetTeam())
+
+	for side, pair := range map[string][2]*cfbd.AdvancedGameStatSide{
+		"offense": {advanced.GetOffense(), texas.AdvancedGameStat().Offense},
+		"defense": {advanced.GetDefense(), texas.AdvancedGameStat().Defense},
+	} {
+		want, got := pair[0], pair[1]
+		assert.Equal(t, want.GetPlays(), got.GetPlays(), side)
+		assert.InDelta(t, want.GetPpa(), got.GetPpa(), 0.01, side)
+		assert.InDelta(t, want.GetSuccessRate(), got.GetSuccessRate(), 0.01,
+			side)
+		assert.InDelta(t, want.GetExplosiveness(), got.GetExplosiveness(),
+			0.05, side)
+		assert.InDelta(t, want.GetLineYards(), got.GetLineYards(), 0.1, side)
+		assert.InDelta(t, want.GetStuffRate(), got.GetStuffRate(), 0.01,
+			side)
+		assert.InDelta(t, want.GetStandardDowns().GetSuccessRate(),
+			got.GetStandardDowns().GetSuccessRate(), 0.01, side)
+		assert.InDelta(t, want.GetPassingDowns().GetSuccessRate(),
+			got.GetPassingDowns().GetSuccessRate(), 0.01, side)
+	}
+
+	got := texas.PredictedPointsAdded()
+	// ppa_games rounds to two decimals.
+	assert.InDelta(t, ppa.GetOffense().GetOverall(),
+		got.GetOffense().GetOverall(), 0.01)
+	assert.InDelta(t, ppa.GetOffense().GetPassing(),
+		got.GetOffense().GetPassing(), 0.01)
+	assert.InDelta(t, ppa.GetOffense().GetRushing(),
+		got.GetOffense().GetRushing(), 0.01)
+	assert.InDelta(t, ppa.GetDefense().GetOverall(),
+		got.GetDefense().GetOverall(), 0.01)
+}
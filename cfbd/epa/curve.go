@@ -0,0 +1,138 @@
+package epa
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// Source is the subset of *cfbd.Client used by BuildCurve.
+type Source interface {
+	GetPredictedPoints(
+		ctx context.Context, request cfbd.GetPredictedPointsRequest,
+	) ([]*cfbd.PredictedPointsValue, error)
+}
+
+var _ Source = (*cfbd.Client)(nil)
+
+const (
+	maxDown = 4
+	// fieldLength is the distance between the goal lines.
+	fieldLength = 100
+)
+
+type situation struct {
+	down, distance int32
+}
+
+// points is one down and distance's expected points by yard line, sorted
+// by yard line.
+type points []*cfbd.PredictedPointsValue
+
+// Curve gives the expected points of a down, distance and field position
+// from GetPredictedPoints values.
+type Curve struct {
+	curves map[situation]points
+}
+
+// NewCurve returns an empty curve.
+func NewCurve() *Curve {
+	return &Curve{curves: make(map[situation]points)}
+}
+
+// BuildCurve fetches the expected points of every down and of distances
+// from 1 to maxDistance from src. Longer distances use maxDistance.
+func BuildCurve(
+	ctx context.Context, src Source, maxDistance int32,
+) (*Curve, error) {
+	c := NewCurve()
+	for down := int32(1); down <= maxDown; down++ {
+		for distance := int32(1); distance <= maxDistance; distance++ {
+			values, err := src.GetPredictedPoints(ctx,
+				cfbd.GetPredictedPointsRequest{
+					Down: down, Distance: distance,
+				})
+			if err != nil {
+				return nil, fmt.Errorf(
+					"could not get predicted points for %d and %d; %w",
+					down, distance, err)
+			}
+			c.Add(down, distance, values)
+		}
+	}
+
+	return c, nil
+}
+
+// Add sets the expected points of down and distance. Yard lines are
+// GetPredictedPoints' yard_line: the distance from the offense's own goal
+// line.
+func (c *Curve) Add(
+	down, distance int32, values []*cfbd.PredictedPointsValue,
+) {
+	sorted := slices.Clone(values)
+	slices.SortFunc(sorted, func(a, b *cfbd.PredictedPointsValue) int {
+		return int(a.GetYardLine() - b.GetYardLine())
+	})
+	c.curves[situation{down, distance}] = sorted
+}
+
+// EP returns the expected points of the offense at down and distance with
+// yardsToGoal to go. Distances without values use the nearest distance of
+// the same down, and yard lines between values are interpolated. ok is
+// false when the curve has no values for down.
+func (c *Curve) EP(down, distance, yardsToGoal int32) (float64, bool) {
+	pts, ok := c.nearest(down, max(distance, 1))
+	if !ok {
+		return 0, false
+	}
+
+	return pts.at(fieldLength - yardsToGoal), true
+}
+
+func (c *Curve) nearest(down, distance int32) (points, bool) {
+	var best points
+	bestGap := int32(-1)
+	for s, pts := range c.curves {
+		if s.down != down || len(pts) == 0 {
+			continue
+		}
+		gap := s.distance - distance
+		if gap < 0 {
+			gap = -gap
+		}
+		// Prefer the shorter distance between two equally near ones, so
+		// that lookups do not depend on map order.
+		if bestGap < 0 || gap < bestGap ||
+			gap == bestGap && s.distance < distance {
+			best, bestGap = pts, gap
+		}
+	}
+
+	return best, bestGap >= 0
+}
+
+// at interpolates the expected points at yard line, holding the ends.
+func (pts points) at(yardLine int32) float64 {
+	i, found := slices.BinarySearchFunc(pts, yardLine,
+		func(v *cfbd.PredictedPointsValue, line int32) int {
+			return int(v.GetYardLine() - line)
+		})
+	switch {
+	case found:
+		return pts[i].GetPredictedPoints()
+	case i == 0:
+		return pts[0].GetPredictedPoints()
+	case i == len(pts):
+		return pts[len(pts)-1].GetPredictedPoints()
+	}
+
+	lo, hi := pts[i-1], pts[i]
+	t := float64(yardLine-lo.GetYardLine()) /
+		float64(hi.GetYardLine()-lo.GetYardLine())
+
+	return lo.GetPredictedPoints() +
+		t*(hi.GetPredictedPoints()-lo.GetPredictedPoints())
+}
@@ -0,0 +1,89 @@
+package epa
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadPredicted(t *testing.T) []*cfbd.PredictedPointsValue {
+	return fixture.Load(t, "ppa_predicted.json",
+		func() *cfbd.PredictedPointsValue {
+			return &cfbd.PredictedPointsValue{}
+		})
+}
+
+func TestCurve_Fixture_ShouldLookUpByYardsToGoal(t *testing.T) {
+	c := NewCurve()
+	c.Add(1, 10, loadPredicted(t))
+
+	ep, ok := c.EP(1, 10, 10)
+	require.True(t, ok)
+	assert.InDelta(t, 4.49, ep, 1e-12)
+
+	ep, _ = c.EP(1, 10, 99)
+	assert.InDelta(t, 0.01, ep, 1e-12)
+
+	// Beyond the last value the end is held.
+	ep, _ = c.EP(1, 10, 5)
+	assert.InDelta(t, 4.49, ep, 1e-12)
+
+	_, ok = c.EP(2, 10, 50)
+	assert.False(t, ok)
+}
+
+func TestCurve_Gaps_ShouldInterpolateAndUseNearestDistance(t *testing.T) {
+	c := NewCurve()
+	c.Add(3, 2, []*cfbd.PredictedPointsValue{
+		{YardLine: 60, PredictedPoints: 3},
+		{YardLine: 40, PredictedPoints: 1},
+	})
+	c.Add(3, 6, []*cfbd.PredictedPointsValue{
+		{YardLine: 50, PredictedPoints: 0},
+	})
+
+	ep, ok := c.EP(3, 1, 50)
+	require.True(t, ok)
+	assert.InDelta(t, 2, ep, 1e-12)
+
+	// Four is as near to 2 as to 6; the shorter distance wins.
+	ep, _ = c.EP(3, 4, 50)
+	assert.InDelta(t, 2, ep, 1e-12)
+
+	ep, _ = c.EP(3, 15, 50)
+	assert.Zero(t, ep)
+}
+
+type fakeSource struct {
+	got []cfbd.GetPredictedPointsRequest
+	err error
+}
+
+func (f *fakeSource) GetPredictedPoints(
+	_ context.Context, request cfbd.GetPredictedPointsRequest,
+) ([]*cfbd.PredictedPointsValue, error) {
+	f.got = append(f.got, request)
+	return []*cfbd.PredictedPointsValue{
+		{YardLine: 50, PredictedPoints: float64(request.Down)},
+	}, f.err
+}
+
+func TestBuildCurve_ShouldFetchEveryDownAndDistance(t *testing.T) {
+	src := &fakeSource{}
+
+	c, err := BuildCurve(context.Background(), src, 3)
+	require.NoError(t, err)
+
+	assert.Len(t, src.got, 12)
+	ep, _ := c.EP(4, 20, 50)
+	assert.InDelta(t, 4, ep, 1e-12)
+
+	boom := errors.New("boom")
+	_, err = BuildCurve(context.Background(), &fakeSource{err: boom}, 3)
+	assert.ErrorIs(t, err, boom)
+}
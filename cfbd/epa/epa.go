@@ -0,0 +1,322 @@
+// Package epa rebuilds CFBD-style play value metrics from raw plays: the
+// expected points before and after each scrimmage play, expected points
+// added (EPA), success, garbage time and explosiveness, and aggregates
+// them into the numbers AdvancedGameStat and TeamGamePredictedPointsAdded
+// report.
+package epa
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/garbage"
+	"github.com/clintrovert/cfbd-go/cfbd/playtext"
+)
+
+// Point values of scores.
+const (
+	Touchdown = 7
+	Safety    = -2
+)
+
+// Kind is the kind of a scrimmage play.
+type Kind int
+
+const (
+	// Rush is a designed run.
+	Rush Kind = iota
+	// Pass is a dropback: a completion, incompletion, interception or
+	// sack.
+	Pass
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Rush:
+		return "rush"
+	case Pass:
+		return "pass"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Play types, as GetPlays names them.
+var (
+	rushTypes = []string{"Rush", "Rushing Touchdown"}
+	passTypes = []string{
+		"Pass", "Pass Reception", "Pass Completion", "Pass Incompletion",
+		"Passing Touchdown", "Sack", "Pass Interception",
+		"Pass Interception Return", "Interception",
+		"Interception Return Touchdown",
+	}
+	// textTypes are rushes or passes depending on the play text.
+	textTypes = []string{
+		"Fumble Recovery (Own)", "Fumble Recovery (Opponent)",
+		"Fumble Return Touchdown", "Safety",
+	}
+	defensiveTouchdowns = []string{
+		"Interception Return Touchdown", "Fumble Return Touchdown",
+	}
+	turnovers = []string{
+		"Pass Interception", "Pass Interception Return", "Interception",
+		"Interception Return Touchdown", "Fumble Recovery (Opponent)",
+		"Fumble Return Touchdown",
+	}
+	safeties = []string{"Safety"}
+	// stateless are plays whose down and distance do not describe the
+	// state of the game.
+	stateless = []string{
+		"Kickoff", "Kickoff Return (Offense)", "Kickoff Return Touchdown",
+		"Extra Point Good", "Extra Point Missed", "Two Point Pass",
+		"Two Point Rush", "Defensive 2pt Conversion", "Timeout",
+		"End Period", "End of Half", "End of Game", "End of Regulation",
+		"Uncategorized",
+	}
+)
+
+// Success thresholds: the share of the distance a play must gain on each
+// down.
+const (
+	firstDownShare  = 0.5
+	secondDownShare = 0.7
+)
+
+// Passing downs are second down with at least passingSecond yards to go
+// and third and fourth down with at least passingLate yards to go.
+const (
+	passingSecond = 8
+	passingLate   = 5
+)
+
+// Option configures a Calculator.
+type Option func(*Calculator)
+
+// WithReportedPPA uses Play.ppa as a play's EPA when the API populated it,
+// falling back to the curve otherwise.
+func WithReportedPPA() Option {
+	return func(c *Calculator) {
+		c.reported = true
+	}
+}
+
+// WithGarbageTime sets the classifier that marks garbage time. The
+// default uses garbage.Standard, which a nil g keeps.
+func WithGarbageTime(g *garbage.Classifier) Option {
+	return func(c *Calculator) {
+		if g != nil {
+			c.garbage = g
+		}
+	}
+}
+
+// Value is the value of one scrimmage play.
+type Value struct {
+	Play *cfbd.Play
+	Kind Kind
+	// EPBefore and EPAfter are the offense's expected points before and
+	// after the play, and EPA is their difference.
+	EPBefore float64
+	EPAfter  float64
+	EPA      float64
+	// Success follows the standard definition: half the distance on first
+	// down, 70% of it on second and all of it on third and fourth, or a
+	// touchdown. Turnovers and safeties are never successful.
+	Success bool
+	// PassingDown is set on second and 8 or more and on third and fourth
+	// and 5 or more; other downs are standard downs.
+	PassingDown bool
+	// GarbageTime is set when the play's classifier marked it.
+	GarbageTime bool
+	// Turnover is set on interceptions, lost fumbles and turnovers on
+	// downs.
+	Turnover bool
+}
+
+// Calculator values plays with an expected points curve.
+type Calculator struct {
+	curve    *Curve
+	reported bool
+	garbage  *garbage.Classifier
+}
+
+// New returns a calculator over curve.
+func New(curve *Curve, opts ...Option) *Calculator {
+	c := &Calculator{curve: curve, garbage: garbage.New()}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Plays values every rush and pass among plays, which may span games.
+// Plays are put in game order by game and play ID, and the state after a
+// play is taken from the next play of the same half that has a down and
+// distance. Plays the curve has no values for are left out.
+func (c *Calculator) Plays(plays []*cfbd.Play) []Value {
+	sorted := slices.Clone(plays)
+	slices.SortStableFunc(sorted, func(a, b *cfbd.Play) int {
+		return cmp.Or(
+			cmp.Compare(a.GetGameId(), b.GetGameId()),
+			cmp.Compare(len(a.GetId()), len(b.GetId())),
+			cmp.Compare(a.GetId(), b.GetId()),
+		)
+	})
+
+	var out []Value
+	for i, p := range sorted {
+		kind, ok := kindOf(p)
+		if !ok {
+			continue
+		}
+		v, ok := c.value(p, kind, next(sorted, i))
+		if ok {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+func (c *Calculator) value(
+	p *cfbd.Play, kind Kind, after *cfbd.Play,
+) (Value, bool) {
+	before, ok := c.curve.EP(p.GetDown(), p.GetDistance(), p.GetYardsToGoal())
+	if !ok {
+		return Value{}, false
+	}
+	epAfter, ok := c.after(p, after)
+	if !ok {
+		return Value{}, false
+	}
+
+	v := Value{
+		Play:        p,
+		Kind:        kind,
+		EPBefore:    before,
+		EPAfter:     epAfter,
+		EPA:         epAfter - before,
+		PassingDown: passingDown(p.GetDown(), p.GetDistance()),
+		GarbageTime: c.garbage.Play(p),
+		Turnover: slices.Contains(turnovers, p.GetPlayType()) ||
+			after != nil && after.GetOffense() != p.GetOffense() &&
+				!p.GetScoring(),
+	}
+	if c.reported && p.Ppa != nil {
+		v.EPA = p.GetPpa()
+		v.EPAfter = before + v.EPA
+	}
+	v.Success = success(p, v.Turnover)
+
+	return v, true
+}
+
+// after returns the offense's expected points after p, given the next
+// play with a game state, or nil at the end of the half.
+func (c *Calculator) after(p, next *cfbd.Play) (float64, bool) {
+	switch {
+	case slices.Contains(defensiveTouchdowns, p.GetPlayType()):
+		return -Touchdown, true
+	case slices.Contains(safeties, p.GetPlayType()):
+		return Safety, true
+	case p.GetScoring():
+		return Touchdown, true
+	case next == nil:
+		return 0, true
+	}
+
+	ep, ok := c.curve.EP(
+		next.GetDown(), next.GetDistance(), next.GetYardsToGoal())
+	if !ok {
+		return 0, false
+	}
+	if next.GetOffense() != p.GetOffense() {
+		return -ep, true
+	}
+
+	return ep, true
+}
+
+// next returns the first play after plays[i] in the same game and half
+// with a game state, or nil.
+func next(plays []*cfbd.Play, i int) *cfbd.Play {
+	p := plays[i]
+	for _, n := range plays[i+1:] {
+		if n.GetGameId() != p.GetGameId() ||
+			half(n.GetPeriod()) != half(p.GetPeriod()) {
+			return nil
+		}
+		if hasState(n) {
+			return n
+		}
+	}
+
+	return nil
+}
+
+// half numbers the halves, with each overtime period its own half.
+func half(period int32) int32 {
+	const quartersPerHalf = 2
+	if period > 2*quartersPerHalf {
+		return period
+	}
+
+	return (period + 1) / quartersPerHalf
+}
+
+func hasState(p *cfbd.Play) bool {
+	return p.GetDown() >= 1 && p.GetDown() <= maxDown &&
+		p.GetYardsToGoal() > 0 && p.GetYardsToGoal() < fieldLength &&
+		!slices.Contains(stateless, p.GetPlayType())
+}
+
+func kindOf(p *cfbd.Play) (Kind, bool) {
+	t := p.GetPlayType()
+	switch {
+	case slices.Contains(rushTypes, t):
+		return Rush, true
+	case slices.Contains(passTypes, t):
+		return Pass, true
+	case slices.Contains(textTypes, t):
+		parsed := playtext.ParsePlay(p)
+		if parsed.Passer != "" || parsed.Sack {
+			return Pass, true
+		}
+		return Rush, true
+	default:
+		return 0, false
+	}
+}
+
+func success(p *cfbd.Play, turnover bool) bool {
+	switch {
+	case turnover, slices.Contains(safeties, p.GetPlayType()):
+		return false
+	case p.GetScoring():
+		return true
+	}
+
+	need := float64(p.GetDistance())
+	switch p.GetDown() {
+	case 1:
+		need *= firstDownShare
+	case 2:
+		need *= secondDownShare
+	}
+
+	return float64(p.GetYardsGained()) >= need
+}
+
+func passingDown(down, distance int32) bool {
+	switch down {
+	case 2:
+		return distance >= passingSecond
+	case 3, 4:
+		return distance >= passingLate
+	default:
+		return false
+	}
+}
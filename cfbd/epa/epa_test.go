@@ -0,0 +1,178 @@
+package epa
+
+import (
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/garbage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// linear is a curve worth a point for every 20 yards from the offense's
+// own goal line, less a point, and half a point less for each down.
+func linear() *Curve {
+	c := NewCurve()
+	for down := int32(1); down <= 4; down++ {
+		var values []*cfbd.PredictedPointsValue
+		for line := int32(1); line < 100; line++ {
+			values = append(values, &cfbd.PredictedPointsValue{
+				YardLine: line,
+				PredictedPoints: float64(line)/20 - 1 -
+					0.5*float64(down-1),
+			})
+		}
+		c.Add(down, 10, values)
+	}
+
+	return c
+}
+
+type snap struct {
+	id, drive              string
+	period                 int32
+	offense, defense       string
+	down, distance, toGoal int32
+	playType               string
+	yards                  int32
+}
+
+func (s snap) play() *cfbd.Play {
+	return &cfbd.Play{
+		Id:          s.id,
+		GameId:      1,
+		DriveId:     s.drive,
+		Period:      s.period,
+		Offense:     s.offense,
+		Defense:     s.defense,
+		Down:        s.down,
+		Distance:    s.distance,
+		YardsToGoal: s.toGoal,
+		PlayType:    s.playType,
+		YardsGained: s.yards,
+		Scoring:     s.playType == "Rushing Touchdown",
+	}
+}
+
+// game is a first half in which A scores a touchdown, B throws an
+// interception and A's last drive runs out the half.
+func game() []*cfbd.Play {
+	snaps := []snap{
+		{"1001", "d1", 1, "A", "B", 1, 10, 75, "Rush", 4},
+		{"1002", "d1", 1, "A", "B", 2, 6, 71, "Pass Reception", 20},
+		{"1003", "d1", 1, "A", "B", 1, 10, 51, "Pass Incompletion", 0},
+		{"1004", "d1", 1, "A", "B", 2, 10, 51, "Rushing Touchdown", 51},
+		{"1005", "d1", 1, "A", "B", 0, 0, 3, "Extra Point Good", 0},
+		{"1006", "d2", 1, "A", "B", 0, 0, 65, "Kickoff", 0},
+		{"1007", "d2", 1, "B", "A", 1, 10, 75, "Pass Interception Return", 0},
+		{"1008", "d3", 2, "A", "B", 1, 10, 40, "Sack", -8},
+		{"1009", "d3", 2, "A", "B", 2, 18, 48, "Timeout", 0},
+		{"1010", "d3", 2, "A", "B", 2, 18, 48, "Rush", 2},
+		{"1011", "d4", 3, "B", "A", 0, 0, 65, "Kickoff", 0},
+	}
+	var plays []*cfbd.Play
+	for _, s := range snaps {
+		plays = append(plays, s.play())
+	}
+
+	return plays
+}
+
+func TestPlays_Game_ShouldValueScrimmagePlays(t *testing.T) {
+	plays := game()
+	// Shuffled input is put back in game order.
+	plays[0], plays[5] = plays[5], plays[0]
+
+	values := New(linear()).Plays(plays)
+
+	require.Len(t, values, 7)
+	want := []struct {
+		id      string
+		kind    Kind
+		before  float64
+		epa     float64
+		success bool
+	}{
+		{"1001", Rush, 0.25, -0.3, false},
+		{"1002", Pass, -0.05, 1.5, true},
+		{"1003", Pass, 1.45, -0.5, false},
+		{"1004", Rush, 0.95, 6.05, true},
+		{"1007", Pass, 0.25, -2.25, false},
+		{"1008", Pass, 2, -0.9, false},
+		{"1010", Rush, 1.1, -1.1, false},
+	}
+	for i, w := range want {
+		v := values[i]
+		assert.Equal(t, w.id, v.Play.GetId())
+		assert.Equal(t, w.kind, v.Kind, w.id)
+		assert.InDelta(t, w.before, v.EPBefore, 1e-9, w.id)
+		assert.InDelta(t, w.epa, v.EPA, 1e-9, w.id)
+		assert.InDelta(t, v.EPAfter-v.EPBefore, v.EPA, 1e-9, w.id)
+		assert.Equal(t, w.success, v.Success, w.id)
+	}
+	assert.True(t, values[4].Turnover)
+	assert.False(t, values[3].Turnover)
+	assert.True(t, values[6].PassingDown)
+	assert.False(t, values[0].PassingDown)
+}
+
+func TestPlays_ReportedPPA_ShouldPreferAPIValue(t *testing.T) {
+	plays := game()
+	ppa := 0.1
+	plays[0].Ppa = &ppa
+
+	computed := New(linear()).Plays(plays)[0]
+	reported := New(linear(), WithReportedPPA()).Plays(plays)[0]
+
+	assert.InDelta(t, -0.3, computed.EPA, 1e-9)
+	assert.InDelta(t, 0.1, reported.EPA, 1e-9)
+	assert.InDelta(t, 0.35, reported.EPAfter, 1e-9)
+}
+
+func TestPlays_Margin_ShouldFlagGarbageTime(t *testing.T) {
+	blowout := snap{"1", "d", 3, "A", "B", 1, 10, 75, "Rush", 3}.play()
+	blowout.OffenseScore, blowout.DefenseScore = 3, 35
+	early := snap{"1", "d", 1, "A", "B", 1, 10, 75, "Rush", 3}.play()
+	early.OffenseScore = 49
+
+	values := New(linear()).Plays([]*cfbd.Play{blowout})
+	require.Len(t, values, 1)
+	assert.True(t, values[0].GarbageTime)
+
+	values = New(linear()).Plays([]*cfbd.Play{early})
+	assert.False(t, values[0].GarbageTime)
+
+	strict := garbage.New(garbage.WithThresholds(garbage.Thresholds{40}))
+	values = New(linear(), WithGarbageTime(strict)).
+		Plays([]*cfbd.Play{early})
+	assert.True(t, values[0].GarbageTime)
+
+	values = New(linear(), WithGarbageTime(nil)).
+		Plays([]*cfbd.Play{blowout})
+	assert.True(t, values[0].GarbageTime)
+}
+
+func TestPlays_FumbleAndSafety_ShouldUseTextAndScore(t *testing.T) {
+	fumble := snap{"1", "d", 1, "A", "B", 1, 10, 50,
+		"Fumble Recovery (Opponent)", 0}.play()
+	fumble.PlayText = "Jane Doe pass complete to John Roe for 5 yds " +
+		"John Roe fumbled, recovered by B Sam Poe"
+	safety := snap{"2", "d", 1, "A", "B", 2, 10, 99, "Safety", -1}.play()
+	safety.PlayText = "John Roe run for a loss of 1 yard for a SAFETY"
+	safety.Scoring = true
+	after := snap{"3", "e", 1, "B", "A", 1, 10, 50, "Rush", 0}.play()
+
+	values := New(linear()).Plays([]*cfbd.Play{fumble, safety, after})
+
+	require.Len(t, values, 3)
+	assert.Equal(t, Pass, values[0].Kind)
+	assert.True(t, values[0].Turnover)
+	assert.Equal(t, Rush, values[1].Kind)
+	assert.InDelta(t, Safety, values[1].EPAfter, 1e-9)
+	assert.False(t, values[1].Success)
+}
+
+func TestKind_String(t *testing.T) {
+	assert.Equal(t, "pass", Pass.String())
+	assert.Equal(t, "Kind(5)", Kind(5).String())
+}
@@ -0,0 +1,461 @@
+package export
+
+import (
+	"fmt"
+	"iter"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// timestampType is the Arrow type of google.protobuf.Timestamp fields.
+var timestampType = &arrow.TimestampType{
+	Unit:     arrow.Microsecond,
+	TimeZone: "UTC",
+}
+
+// ArrowSchema derives the Arrow schema of md. This is the same mapping the
+// Parquet exporter uses: nested messages become nullable structs, repeated
+// fields become lists of non-null elements, map fields become maps, proto3
+// optional scalars and wrapper messages become nullable columns, and every
+// other scalar is required. The schema metadata key "proto.message" holds
+// the message's full name.
+func ArrowSchema(md protoreflect.MessageDescriptor) *arrow.Schema {
+	metadata := arrow.NewMetadata(
+		[]string{"proto.message"}, []string{string(md.FullName())},
+	)
+	return arrow.NewSchema(arrowFields(md), &metadata)
+}
+
+// RecordBuilder appends messages of a single type incrementally and emits
+// them as Arrow record batches, so conversion can sit behind a streaming
+// source without holding every message in memory.
+type RecordBuilder struct {
+	md      protoreflect.MessageDescriptor
+	builder *array.RecordBuilder
+	rows    int
+}
+
+// NewRecordBuilder creates a RecordBuilder for messages described by md,
+// e.g. (&cfbd.Play{}).ProtoReflect().Descriptor(). A nil allocator uses
+// memory.DefaultAllocator. Release must be called when done.
+func NewRecordBuilder(
+	md protoreflect.MessageDescriptor, mem memory.Allocator,
+) *RecordBuilder {
+	if mem == nil {
+		mem = memory.DefaultAllocator
+	}
+
+	return &RecordBuilder{
+		md:      md,
+		builder: array.NewRecordBuilder(mem, ArrowSchema(md)),
+	}
+}
+
+// Schema returns the Arrow schema of the records built.
+func (b *RecordBuilder) Schema() *arrow.Schema {
+	return b.builder.Schema()
+}
+
+// Len returns the number of messages appended since the last record batch.
+func (b *RecordBuilder) Len() int {
+	return b.rows
+}
+
+// Append appends msgs, which must match the builder's message type. Every
+// message is checked before any is appended, so on error the builder is
+// left as it was.
+func (b *RecordBuilder) Append(msgs ...proto.Message) error {
+	for _, m := range msgs {
+		if m == nil {
+			return ErrNilMessage
+		}
+		name := m.ProtoReflect().Descriptor().FullName()
+		if name != b.md.FullName() {
+			return fmt.Errorf(
+				"%s and %s; %w", b.md.FullName(), name, ErrMixedMessageTypes,
+			)
+		}
+		if err := checkMessage(m.ProtoReflect()); err != nil {
+			return err
+		}
+	}
+
+	for _, m := range msgs {
+		if err := appendMessage(b.builder.Field, m.ProtoReflect()); err != nil {
+			return err
+		}
+		b.rows++
+	}
+
+	return nil
+}
+
+// NewRecordBatch returns the appended messages as a record batch and resets
+// the builder. The caller owns the batch and must release it.
+func (b *RecordBuilder) NewRecordBatch() arrow.RecordBatch {
+	b.rows = 0
+	return b.builder.NewRecordBatch()
+}
+
+// Release releases the builder's buffers.
+func (b *RecordBuilder) Release() {
+	b.builder.Release()
+}
+
+// ToRecordBatch converts msgs, which must all be of the same type, into a
+// single record batch. It returns nil when msgs is empty. A nil allocator
+// uses memory.DefaultAllocator.
+func ToRecordBatch(
+	msgs []proto.Message, mem memory.Allocator,
+) (arrow.RecordBatch, error) {
+	md, err := descriptorOf(msgs)
+	if err != nil {
+		return nil, err
+	}
+	if md == nil {
+		return nil, nil
+	}
+
+	b := NewRecordBuilder(md, mem)
+	defer b.Release()
+	if err = b.Append(msgs...); err != nil {
+		return nil, err
+	}
+
+	return b.NewRecordBatch(), nil
+}
+
+// RecordBatches converts a stream of messages into record batches of at
+// most batchSize rows. Only one batch is buffered at a time; each yielded
+// batch is owned by the caller and must be released. Iteration stops after
+// the first error.
+func RecordBatches[T proto.Message](
+	seq iter.Seq[T], batchSize int, mem memory.Allocator,
+) iter.Seq2[arrow.RecordBatch, error] {
+	return func(yield func(arrow.RecordBatch, error) bool) {
+		if batchSize < 1 {
+			yield(nil, fmt.Errorf("batch size must be positive, got %d", batchSize))
+			return
+		}
+
+		var zero T
+		b := NewRecordBuilder(zero.ProtoReflect().Descriptor(), mem)
+		defer b.Release()
+
+		for m := range seq {
+			if err := b.Append(m); err != nil {
+				yield(nil, err)
+				return
+			}
+			if b.Len() >= batchSize && !yield(b.NewRecordBatch(), nil) {
+				return
+			}
+		}
+
+		if b.Len() > 0 {
+			yield(b.NewRecordBatch(), nil)
+		}
+	}
+}
+
+func arrowFields(md protoreflect.MessageDescriptor) []arrow.Field {
+	fields := md.Fields()
+	out := make([]arrow.Field, fields.Len())
+	for i := range fields.Len() {
+		out[i] = arrowField(fields.Get(i))
+	}
+
+	return out
+}
+
+func arrowField(fd protoreflect.FieldDescriptor) arrow.Field {
+	field := arrow.Field{Name: string(fd.Name())}
+	switch {
+	case fd.IsMap():
+		field.Type = arrow.MapOf(
+			arrowElementType(fd.MapKey()), arrowElementType(fd.MapValue()),
+		)
+	case fd.IsList():
+		field.Type = arrow.ListOfNonNullable(arrowElementType(fd))
+	default:
+		field.Type = arrowElementType(fd)
+		field.Nullable = nullable(fd)
+	}
+
+	return field
+}
+
+// arrowElementType returns the Arrow type of a single value of fd.
+// Durations are stored as int64 nanoseconds since Parquet has no duration
+// logical type.
+func arrowElementType(fd protoreflect.FieldDescriptor) arrow.DataType {
+	switch fieldKind(fd) {
+	case kindBool:
+		return arrow.FixedWidthTypes.Boolean
+	case kindInt32:
+		return arrow.PrimitiveTypes.Int32
+	case kindInt64, kindDuration:
+		return arrow.PrimitiveTypes.Int64
+	case kindUint32:
+		return arrow.PrimitiveTypes.Uint32
+	case kindUint64:
+		return arrow.PrimitiveTypes.Uint64
+	case kindFloat:
+		return arrow.PrimitiveTypes.Float32
+	case kindDouble:
+		return arrow.PrimitiveTypes.Float64
+	case kindBytes:
+		return arrow.BinaryTypes.Binary
+	case kindTimestamp:
+		return timestampType
+	case kindMessage:
+		return arrow.StructOf(arrowFields(fd.Message())...)
+	}
+
+	return arrow.BinaryTypes.String
+}
+
+// checkMessage returns the first error converting the values of m, so that
+// a message that cannot be exported is rejected before any of its columns
+// are appended.
+func checkMessage(m protoreflect.Message) error {
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		err = checkField(fd, v)
+		return err == nil
+	})
+
+	return err
+}
+
+func checkField(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch {
+	case fd.IsMap():
+		var err error
+		v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+			err = checkElement(fd.MapValue(), mv)
+			return err == nil
+		})
+		return err
+	case fd.IsList():
+		list := v.List()
+		for i := range list.Len() {
+			if err := checkElement(fd, list.Get(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return checkElement(fd, v)
+}
+
+func checkElement(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	if fieldKind(fd) == kindMessage {
+		return checkMessage(v.Message())
+	}
+	_, _, err := elementValue(fd, v)
+
+	return err
+}
+
+// appendMessage appends m as one row to the field builders of a record or
+// struct built from arrowFields(m.Descriptor()).
+func appendMessage(
+	builders func(i int) array.Builder, m protoreflect.Message,
+) error {
+	fields := m.Descriptor().Fields()
+	for i := range fields.Len() {
+		if err := appendField(builders(i), fields.Get(i), m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func appendField(
+	b array.Builder, fd protoreflect.FieldDescriptor, m protoreflect.Message,
+) error {
+	switch {
+	case fd.IsMap():
+		mb, ok := b.(*array.MapBuilder)
+		if !ok {
+			return builderMismatch(fd, b)
+		}
+		mb.Append(true)
+		var err error
+		m.Get(fd).Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			if err = appendElement(mb.KeyBuilder(), fd.MapKey(), k.Value()); err != nil {
+				return false
+			}
+			err = appendElement(mb.ItemBuilder(), fd.MapValue(), v)
+			return err == nil
+		})
+		return err
+	case fd.IsList():
+		lb, ok := b.(*array.ListBuilder)
+		if !ok {
+			return builderMismatch(fd, b)
+		}
+		lb.Append(true)
+		list := m.Get(fd).List()
+		for i := range list.Len() {
+			if err := appendElement(lb.ValueBuilder(), fd, list.Get(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case fieldKind(fd) == kindMessage:
+		if !m.Has(fd) {
+			// Appending a null struct also appends nulls to its children.
+			b.AppendNull()
+			return nil
+		}
+		return appendElement(b, fd, m.Get(fd))
+	}
+
+	v, ok, err := leafValue(m, fd)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		b.AppendNull()
+		return nil
+	}
+
+	return appendLeaf(b, fd, v)
+}
+
+// appendElement appends a single value of fd, which may be a list element.
+func appendElement(
+	b array.Builder, fd protoreflect.FieldDescriptor, v protoreflect.Value,
+) error {
+	if fieldKind(fd) == kindMessage {
+		sb, ok := b.(*array.StructBuilder)
+		if !ok {
+			return builderMismatch(fd, b)
+		}
+		sb.Append(true)
+		return appendMessage(sb.FieldBuilder, v.Message())
+	}
+
+	value, ok, err := elementValue(fd, v)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		b.AppendNull()
+		return nil
+	}
+
+	return appendLeaf(b, fd, value)
+}
+
+// appendLeaf appends v, as returned by leafValue, to b.
+func appendLeaf(b array.Builder, fd protoreflect.FieldDescriptor, v any) error {
+	if appendScalar(b, v) || appendInteger(b, v) || appendFloat(b, v) ||
+		appendWellKnown(b, v) {
+		return nil
+	}
+
+	return builderMismatch(fd, b)
+}
+
+// appendScalar appends booleans, bytes and strings, which include enum
+// names.
+func appendScalar(b array.Builder, v any) bool {
+	switch builder := b.(type) {
+	case *array.BooleanBuilder:
+		if t, ok := v.(bool); ok {
+			builder.Append(t)
+			return true
+		}
+	case *array.StringBuilder:
+		if t, ok := v.(string); ok {
+			builder.Append(t)
+			return true
+		}
+	case *array.BinaryBuilder:
+		if t, ok := v.([]byte); ok {
+			builder.Append(t)
+			return true
+		}
+	}
+
+	return false
+}
+
+func appendInteger(b array.Builder, v any) bool {
+	switch builder := b.(type) {
+	case *array.Int32Builder:
+		if t, ok := v.(int32); ok {
+			builder.Append(t)
+			return true
+		}
+	case *array.Int64Builder:
+		if t, ok := v.(int64); ok {
+			builder.Append(t)
+			return true
+		}
+	case *array.Uint32Builder:
+		if t, ok := v.(uint32); ok {
+			builder.Append(t)
+			return true
+		}
+	case *array.Uint64Builder:
+		if t, ok := v.(uint64); ok {
+			builder.Append(t)
+			return true
+		}
+	}
+
+	return false
+}
+
+func appendFloat(b array.Builder, v any) bool {
+	switch builder := b.(type) {
+	case *array.Float32Builder:
+		if t, ok := v.(float32); ok {
+			builder.Append(t)
+			return true
+		}
+	case *array.Float64Builder:
+		if t, ok := v.(float64); ok {
+			builder.Append(t)
+			return true
+		}
+	}
+
+	return false
+}
+
+// appendWellKnown appends durations as nanoseconds and timestamps as
+// microseconds.
+func appendWellKnown(b array.Builder, v any) bool {
+	switch builder := b.(type) {
+	case *array.Int64Builder:
+		if t, ok := v.(time.Duration); ok {
+			builder.Append(int64(t))
+			return true
+		}
+	case *array.TimestampBuilder:
+		if t, ok := v.(time.Time); ok {
+			builder.Append(arrow.Timestamp(t.UnixMicro()))
+			return true
+		}
+	}
+
+	return false
+}
+
+func builderMismatch(fd protoreflect.FieldDescriptor, b array.Builder) error {
+	return fmt.Errorf(
+		"field %s cannot be appended to %s", fd.FullName(), b.Type(),
+	)
+}
@@ -0,0 +1,139 @@
+package export
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestArrowSchema_ShouldRecordMessageName(t *testing.T) {
+	sc := ArrowSchema((&cfbd.Play{}).ProtoReflect().Descriptor())
+
+	name, ok := sc.Metadata().GetValue("proto.message")
+	require.True(t, ok)
+	assert.Equal(t, "cfbd.v1.Play", name)
+	field, ok := sc.FieldsByName("ppa")
+	require.True(t, ok)
+	assert.True(t, field[0].Nullable)
+	field, ok = sc.FieldsByName("offense")
+	require.True(t, ok)
+	assert.False(t, field[0].Nullable)
+}
+
+func TestToRecordBatch_Plays_ShouldConvertValues(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+	plays := fixture.Load(t, "plays.json", func() *cfbd.Play { return &cfbd.Play{} })
+
+	rec, err := ToRecordBatch(Messages(plays), mem)
+	require.NoError(t, err)
+	defer rec.Release()
+
+	require.Equal(t, int64(len(plays)), rec.NumRows())
+	ids := column(t, rec, "id").(*array.String)
+	clocks := column(t, rec, "clock").(*array.Struct)
+	minutes := clocks.Field(1).(*array.Int32)
+	ppa := column(t, rec, "ppa").(*array.Float64)
+	for i, play := range plays {
+		assert.Equal(t, play.GetId(), ids.Value(i))
+		assert.Equal(t, play.GetClock().GetMinutes(), minutes.Value(i))
+		assert.Equal(t, play.Ppa == nil, ppa.IsNull(i))
+	}
+}
+
+func TestToRecordBatch_PlayerStats_ShouldSupportAggregation(t *testing.T) {
+	stats := fixture.Load(t, "stat_player_season.json",
+		func() *cfbd.PlayerStat { return &cfbd.PlayerStat{} })
+
+	rec, err := ToRecordBatch(Messages(stats), nil)
+	require.NoError(t, err)
+	defer rec.Release()
+
+	categories := column(t, rec, "category").(*array.String)
+	counts := map[string]int{}
+	for i := range categories.Len() {
+		counts[categories.Value(i)]++
+	}
+	want := map[string]int{}
+	for _, stat := range stats {
+		want[stat.GetCategory()]++
+	}
+	assert.Equal(t, want, counts)
+}
+
+func TestToRecordBatch_Empty_ShouldReturnNil(t *testing.T) {
+	rec, err := ToRecordBatch(nil, nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, rec)
+}
+
+func TestRecordBatches_ShouldBatchStream(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+	games := fixture.Load(t, "games.json", func() *cfbd.Game { return &cfbd.Game{} })
+	games = append(games, games...)
+	games = append(games, &cfbd.Game{Id: 1})
+
+	var sizes []int64
+	var ids []int32
+	for rec, err := range RecordBatches(slices.Values(games), 2, mem) {
+		require.NoError(t, err)
+		sizes = append(sizes, rec.NumRows())
+		col := column(t, rec, "id").(*array.Int32)
+		ids = append(ids, col.Int32Values()...)
+		rec.Release()
+	}
+
+	want := make([]int32, len(games))
+	for i, game := range games {
+		want[i] = game.GetId()
+	}
+	assert.Equal(t, want, ids)
+	for _, size := range sizes[:len(sizes)-1] {
+		assert.Equal(t, int64(2), size)
+	}
+}
+
+func TestToRecordBatch_UnencodableValue_ShouldFail(t *testing.T) {
+	_, err := ToRecordBatch(unencodable(), nil)
+
+	assert.Error(t, err)
+}
+
+func TestRecordBuilder_UnencodableValue_ShouldAppendNothing(t *testing.T) {
+	valid := &cfbd.TeamStat{
+		Team: "Texas", StatValue: structpb.NewNumberValue(16),
+	}
+	b := NewRecordBuilder(valid.ProtoReflect().Descriptor(), nil)
+	defer b.Release()
+
+	require.Error(t, b.Append(append([]proto.Message{valid},
+		unencodable()...)...))
+	assert.Zero(t, b.Len())
+
+	require.NoError(t, b.Append(valid))
+	rec := b.NewRecordBatch()
+	defer rec.Release()
+	assert.Equal(t, int64(1), rec.NumRows())
+	for i := range int(rec.NumCols()) {
+		assert.Equal(t, 1, rec.Column(i).Len(), rec.ColumnName(i))
+	}
+}
+
+func TestRecordBuilder_WrongType_ShouldFail(t *testing.T) {
+	b := NewRecordBuilder((&cfbd.Game{}).ProtoReflect().Descriptor(), nil)
+	defer b.Release()
+
+	assert.ErrorIs(t, b.Append(&cfbd.Play{}), ErrMixedMessageTypes)
+	assert.ErrorIs(t, b.Append(nil), ErrNilMessage)
+	assert.Zero(t, b.Len())
+}
@@ -0,0 +1,359 @@
+package export
+
+import (
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RepeatedMode selects how repeated fields are laid out in CSV output.
+type RepeatedMode int
+
+const (
+	// RepeatedJoin writes a repeated field as one column. Scalar elements
+	// are joined with the join separator; message elements are written as
+	// a JSON array.
+	RepeatedJoin RepeatedMode = iota
+	// RepeatedIndexed writes one column per element (home_line_scores.0,
+	// home_line_scores.1, ...), sized to the longest list in the export.
+	// Message elements are flattened under their index.
+	RepeatedIndexed
+)
+
+const defaultJoinSeparator = ";"
+
+// CSVOption configures CSV.
+type CSVOption func(*csvOptions)
+
+type csvOptions struct {
+	repeated  RepeatedMode
+	separator string
+}
+
+// WithRepeatedMode sets how repeated fields are written. The default is
+// RepeatedJoin.
+func WithRepeatedMode(mode RepeatedMode) CSVOption {
+	return func(o *csvOptions) {
+		o.repeated = mode
+	}
+}
+
+// WithJoinSeparator sets the separator used by RepeatedJoin for scalar
+// elements. The default is ";".
+func WithJoinSeparator(separator string) CSVOption {
+	return func(o *csvOptions) {
+		o.separator = separator
+	}
+}
+
+// CSV writes msgs to w as CSV with a header row. Columns follow the message
+// descriptor: nested messages are flattened into dotted column names such
+// as offense.passing_plays.ppa, unset optional fields and messages are
+// written as blanks, timestamps are written as RFC3339, and Struct/Value
+// fields are written as JSON. Map fields are written as a JSON object.
+//
+// Every message must be of the same type. Nothing is written when msgs is
+// empty.
+func CSV(w io.Writer, msgs []proto.Message, opts ...CSVOption) error {
+	options := csvOptions{
+		repeated:  RepeatedJoin,
+		separator: defaultJoinSeparator,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	md, err := descriptorOf(msgs)
+	if err != nil {
+		return err
+	}
+	if md == nil {
+		return nil
+	}
+
+	layout := &csvLayout{options: options, maxLen: map[string]int{}}
+	if options.repeated == RepeatedIndexed {
+		for _, m := range msgs {
+			layout.measure(m.ProtoReflect(), "")
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	var header []string
+	if err = layout.walk(nil, md, "", "", func(name, _ string) {
+		header = append(header, name)
+	}); err != nil {
+		return err
+	}
+	if err = writer.Write(header); err != nil {
+		return fmt.Errorf("could not write csv header; %w", err)
+	}
+
+	row := make([]string, 0, len(header))
+	for i, m := range msgs {
+		row = row[:0]
+		if err = layout.walk(m.ProtoReflect(), md, "", "", func(_, value string) {
+			row = append(row, value)
+		}); err != nil {
+			return fmt.Errorf("could not flatten message %d; %w", i, err)
+		}
+		if err = writer.Write(row); err != nil {
+			return fmt.Errorf("could not write csv row %d; %w", i, err)
+		}
+	}
+
+	writer.Flush()
+	if err = writer.Error(); err != nil {
+		return fmt.Errorf("could not flush csv; %w", err)
+	}
+
+	return nil
+}
+
+// csvLayout derives the column layout of a message type. In indexed mode
+// the number of columns of a repeated field depends on the data, so maxLen
+// records the longest list seen for each field path.
+type csvLayout struct {
+	options csvOptions
+	maxLen  map[string]int
+}
+
+// measure records the length of every repeated field reachable from m.
+func (l *csvLayout) measure(m protoreflect.Message, prefix string) {
+	fields := m.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		name := prefix + string(fd.Name())
+		switch {
+		case fd.IsMap():
+		case fd.IsList():
+			list := m.Get(fd).List()
+			l.maxLen[name] = max(l.maxLen[name], list.Len())
+			if fieldKind(fd) != kindMessage {
+				continue
+			}
+			for j := range list.Len() {
+				l.measure(list.Get(j).Message(), name+".")
+			}
+		case fieldKind(fd) == kindMessage && m.Has(fd):
+			l.measure(m.Get(fd).Message(), name+".")
+		}
+	}
+}
+
+// walk emits one column per leaf of md in descriptor order. The column
+// order does not depend on m, which may be nil to emit only the header.
+// prefix is the column name prefix, which includes list indexes, and path
+// is the index-free field path used to look up maxLen.
+func (l *csvLayout) walk(
+	m protoreflect.Message,
+	md protoreflect.MessageDescriptor,
+	prefix, path string,
+	emit func(name, value string),
+) error {
+	present := m != nil && m.IsValid()
+	fields := md.Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		name := prefix + string(fd.Name())
+		fieldPath := path + string(fd.Name())
+
+		switch {
+		case fd.IsMap():
+			value := ""
+			if present && m.Get(fd).Map().Len() > 0 {
+				s, err := mapJSON(fd, m.Get(fd).Map())
+				if err != nil {
+					return err
+				}
+				value = s
+			}
+			emit(name, value)
+		case fd.IsList():
+			var list protoreflect.List
+			if present {
+				list = m.Get(fd).List()
+			}
+			if err := l.walkList(fd, list, name, fieldPath, emit); err != nil {
+				return err
+			}
+		case fieldKind(fd) == kindMessage:
+			var sub protoreflect.Message
+			if present && m.Has(fd) {
+				sub = m.Get(fd).Message()
+			}
+			err := l.walk(sub, fd.Message(), name+".", fieldPath+".", emit)
+			if err != nil {
+				return err
+			}
+		default:
+			s, err := formatCell(leafValue(m, fd))
+			if err != nil {
+				return err
+			}
+			emit(name, s)
+		}
+	}
+
+	return nil
+}
+
+func (l *csvLayout) walkList(
+	fd protoreflect.FieldDescriptor,
+	list protoreflect.List,
+	name, path string,
+	emit func(name, value string),
+) error {
+	length := 0
+	if list != nil {
+		length = list.Len()
+	}
+
+	if l.options.repeated == RepeatedJoin {
+		if length == 0 {
+			emit(name, "")
+			return nil
+		}
+		s, err := l.joinList(fd, list)
+		if err != nil {
+			return err
+		}
+		emit(name, s)
+		return nil
+	}
+
+	for i := range l.maxLen[path] {
+		elemName := name + "." + strconv.Itoa(i)
+		if fieldKind(fd) == kindMessage {
+			var elem protoreflect.Message
+			if i < length {
+				elem = list.Get(i).Message()
+			}
+			err := l.walk(elem, fd.Message(), elemName+".", path+".", emit)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		value := ""
+		if i < length {
+			var err error
+			if value, err = formatCell(elementValue(fd, list.Get(i))); err != nil {
+				return err
+			}
+		}
+		emit(elemName, value)
+	}
+
+	return nil
+}
+
+func (l *csvLayout) joinList(
+	fd protoreflect.FieldDescriptor, list protoreflect.List,
+) (string, error) {
+	if fieldKind(fd) != kindMessage {
+		parts := make([]string, list.Len())
+		for i := range list.Len() {
+			s, err := formatCell(elementValue(fd, list.Get(i)))
+			if err != nil {
+				return "", err
+			}
+			parts[i] = s
+		}
+		return strings.Join(parts, l.options.separator), nil
+	}
+
+	parts := make([]json.RawMessage, list.Len())
+	for i := range list.Len() {
+		s, err := marshalJSON(list.Get(i).Message().Interface())
+		if err != nil {
+			return "", err
+		}
+		parts[i] = json.RawMessage(s)
+	}
+	b, err := json.Marshal(parts)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal %s; %w", fd.FullName(), err)
+	}
+
+	return string(b), nil
+}
+
+// mapJSON encodes a map field as a JSON object keyed by the map key.
+func mapJSON(
+	fd protoreflect.FieldDescriptor, m protoreflect.Map,
+) (string, error) {
+	out := make(map[string]any, m.Len())
+	var err error
+	m.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		valueField := fd.MapValue()
+		if fieldKind(valueField) == kindMessage {
+			var s string
+			if s, err = marshalJSON(v.Message().Interface()); err != nil {
+				return false
+			}
+			out[k.String()] = json.RawMessage(s)
+			return true
+		}
+		var value any
+		if value, _, err = elementValue(valueField, v); err != nil {
+			return false
+		}
+		out[k.String()] = value
+		return true
+	})
+	if err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal %s; %w", fd.FullName(), err)
+	}
+
+	return string(b), nil
+}
+
+// formatCell renders a value returned by leafValue or elementValue, passing
+// on its error.
+func formatCell(v any, ok bool, err error) (string, error) {
+	if err != nil || !ok {
+		return "", err
+	}
+
+	switch t := v.(type) {
+	case bool:
+		return strconv.FormatBool(t), nil
+	case int32:
+		return strconv.FormatInt(int64(t), 10), nil
+	case int64:
+		return strconv.FormatInt(t, 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(t), 10), nil
+	case uint64:
+		return strconv.FormatUint(t, 10), nil
+	case float32:
+		return strconv.FormatFloat(float64(t), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), nil
+	case string:
+		return t, nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(t), nil
+	case time.Time:
+		return t.Format(time.RFC3339), nil
+	case time.Duration:
+		return t.String(), nil
+	}
+
+	return fmt.Sprint(v), nil
+}
@@ -0,0 +1,168 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// readCSV parses CSV output into maps keyed by column name.
+func readCSV(t *testing.T, b []byte) ([]string, []map[string]string) {
+	records, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	require.NoError(t, err)
+	require.NotEmpty(t, records)
+
+	header := records[0]
+	rows := make([]map[string]string, 0, len(records)-1)
+	for _, record := range records[1:] {
+		require.Len(t, record, len(header))
+		row := make(map[string]string, len(header))
+		for i, name := range header {
+			row[name] = record[i]
+		}
+		rows = append(rows, row)
+	}
+
+	return header, rows
+}
+
+func TestCSV_Games_ShouldFlattenScalarsAndTimestamps(t *testing.T) {
+	games := fixture.Load(t, "games.json", func() *cfbd.Game { return &cfbd.Game{} })
+	var buf bytes.Buffer
+
+	require.NoError(t, CSV(&buf, Messages(games)))
+
+	header, rows := readCSV(t, buf.Bytes())
+	require.Len(t, rows, len(games))
+	assert.Equal(t, "id", header[0])
+	row := rows[0]
+	assert.Equal(t, "401752677", row["id"])
+	assert.Equal(t, "2025-08-30T16:00:00Z", row["start_date"])
+	assert.Equal(t, "false", row["start_time_TBD"])
+	assert.Equal(t, "", row["attendance"])
+	assert.Equal(t, "3861", row["venue_id"])
+	assert.Equal(t, "0;7;0;7", row["home_line_scores"])
+	assert.Equal(t, "0.750937283039093", row["home_postgame_win_probability"])
+}
+
+func TestCSV_IndexedRepeated_ShouldWriteColumnPerElement(t *testing.T) {
+	games := fixture.Load(t, "games.json", func() *cfbd.Game { return &cfbd.Game{} })
+	var buf bytes.Buffer
+
+	require.NoError(t, CSV(&buf, Messages(games), WithRepeatedMode(RepeatedIndexed)))
+
+	header, rows := readCSV(t, buf.Bytes())
+	assert.NotContains(t, header, "home_line_scores")
+	assert.Contains(t, header, "home_line_scores.3")
+	assert.Equal(t, "7", rows[0]["home_line_scores.1"])
+	assert.Equal(t, "7", rows[0]["away_line_scores.3"])
+}
+
+func TestCSV_JoinSeparator_ShouldBeConfigurable(t *testing.T) {
+	game := &cfbd.Game{HomeLineScores: []int32{3, 14}}
+	var buf bytes.Buffer
+
+	require.NoError(t, CSV(&buf, Messages([]*cfbd.Game{game}), WithJoinSeparator("|")))
+
+	_, rows := readCSV(t, buf.Bytes())
+	assert.Equal(t, "3|14", rows[0]["home_line_scores"])
+}
+
+func TestCSV_NestedMessages_ShouldUseDottedColumns(t *testing.T) {
+	stats := fixture.Load(t, "stat_season_advanced.json",
+		func() *cfbd.AdvancedSeasonStat { return &cfbd.AdvancedSeasonStat{} })
+	var buf bytes.Buffer
+
+	require.NoError(t, CSV(&buf, Messages(stats)))
+
+	header, rows := readCSV(t, buf.Bytes())
+	assert.Contains(t, header, "offense.passing_plays.ppa")
+	assert.Contains(t, header, "defense.havoc.front_seven")
+	assert.NotEmpty(t, rows[0]["offense.passing_plays.ppa"])
+
+	// An unset nested message leaves every one of its columns blank.
+	var empty bytes.Buffer
+	require.NoError(t, CSV(&empty, Messages([]*cfbd.AdvancedSeasonStat{{Team: "Texas"}})))
+	_, emptyRows := readCSV(t, empty.Bytes())
+	assert.Equal(t, "", emptyRows[0]["offense.passing_plays.ppa"])
+	assert.Equal(t, "Texas", emptyRows[0]["team"])
+}
+
+func TestCSV_IndexedRepeatedMessages_ShouldFlattenElements(t *testing.T) {
+	games := fixture.Load(t, "games_teams.json",
+		func() *cfbd.GameTeamStats { return &cfbd.GameTeamStats{} })
+	var buf bytes.Buffer
+
+	require.NoError(t, CSV(&buf, Messages(games), WithRepeatedMode(RepeatedIndexed)))
+
+	header, rows := readCSV(t, buf.Bytes())
+	assert.Contains(t, header, "teams.1.stats.0.category")
+	assert.Equal(t, "Texas", rows[0]["teams.1.team"])
+	assert.Equal(t, "firstDowns", rows[0]["teams.1.stats.0.category"])
+}
+
+func TestCSV_ValueFields_ShouldWriteJSON(t *testing.T) {
+	stats := fixture.Load(t, "stat_season.json",
+		func() *cfbd.TeamStat { return &cfbd.TeamStat{} })
+	var buf bytes.Buffer
+
+	require.NoError(t, CSV(&buf, Messages(stats)))
+
+	_, rows := readCSV(t, buf.Bytes())
+	var value float64
+	require.NoError(t, json.Unmarshal([]byte(rows[0]["stat_value"]), &value))
+	assert.Equal(t, 16.0, value)
+}
+
+// unencodable returns a stat whose value protojson refuses to encode.
+func unencodable() []proto.Message {
+	return []proto.Message{&cfbd.TeamStat{
+		Team: "Texas", StatValue: structpb.NewNumberValue(math.NaN()),
+	}}
+}
+
+func TestCSV_UnencodableValue_ShouldFail(t *testing.T) {
+	var buf bytes.Buffer
+
+	assert.Error(t, CSV(&buf, unencodable()))
+}
+
+func TestCSV_Scoreboard_ShouldFlattenTypedSubMessages(t *testing.T) {
+	boards := fixture.Load(t, "scoreboard.json",
+		func() *cfbd.Scoreboard { return &cfbd.Scoreboard{} })
+	var buf bytes.Buffer
+
+	require.NoError(t, CSV(&buf, Messages(boards)))
+
+	_, rows := readCSV(t, buf.Bytes())
+	assert.Equal(t, "Baltimore", rows[0]["venue.city"])
+	assert.Equal(t, "Navy Midshipmen", rows[0]["home_team.name"])
+	assert.Equal(t, "7;0;3;7", rows[0]["home_team.line_scores"])
+	assert.Equal(t, "", rows[0]["home_team.win_probability"])
+	assert.Equal(t, "-6", rows[0]["betting.spread"])
+}
+
+func TestCSV_MixedTypes_ShouldFail(t *testing.T) {
+	msgs := []proto.Message{&cfbd.Game{}, &cfbd.Play{}}
+
+	err := CSV(&bytes.Buffer{}, msgs)
+
+	assert.ErrorIs(t, err, ErrMixedMessageTypes)
+}
+
+func TestCSV_Empty_ShouldWriteNothing(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, CSV(&buf, nil))
+
+	assert.Zero(t, buf.Len())
+}
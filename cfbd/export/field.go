@@ -0,0 +1,258 @@
+// Package export converts CFBD response messages into tabular formats by
+// walking their protobuf descriptors, so every message type in the cfbd
+// package can be exported without hand-written flatteners.
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	// ErrMixedMessageTypes is returned when a slice passed to an exporter
+	// holds more than one message type.
+	ErrMixedMessageTypes = errors.New("messages are not all the same type")
+	// ErrNilMessage is returned when a slice passed to an exporter holds a
+	// nil message.
+	ErrNilMessage = errors.New("message was nil")
+)
+
+// kind is the logical type a field is exported as, independent of the
+// output format.
+type kind int
+
+const (
+	kindBool kind = iota
+	kindInt32
+	kindInt64
+	kindUint32
+	kindUint64
+	kindFloat
+	kindDouble
+	kindString
+	kindBytes
+	kindTimestamp
+	kindDuration
+	// kindJSON holds google.protobuf.Struct, Value and ListValue fields,
+	// which are exported as their JSON encoding.
+	kindJSON
+	// kindMessage is any other message; exporters recurse into it.
+	kindMessage
+)
+
+const (
+	timestampName = "google.protobuf.Timestamp"
+	durationName  = "google.protobuf.Duration"
+)
+
+var jsonMessages = map[protoreflect.FullName]bool{
+	"google.protobuf.Struct":    true,
+	"google.protobuf.Value":     true,
+	"google.protobuf.ListValue": true,
+}
+
+var wrapperMessages = map[protoreflect.FullName]bool{
+	"google.protobuf.DoubleValue": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.BoolValue":   true,
+	"google.protobuf.StringValue": true,
+	"google.protobuf.BytesValue":  true,
+}
+
+var jsonMarshaller = protojson.MarshalOptions{}
+
+// Messages converts a typed slice such as []*cfbd.Game into the
+// []proto.Message accepted by the exporters.
+func Messages[T proto.Message](in []T) []proto.Message {
+	out := make([]proto.Message, len(in))
+	for i, m := range in {
+		out[i] = m
+	}
+
+	return out
+}
+
+// descriptorOf returns the shared descriptor of msgs, or nil when msgs is
+// empty.
+func descriptorOf(
+	msgs []proto.Message,
+) (protoreflect.MessageDescriptor, error) {
+	var md protoreflect.MessageDescriptor
+	for i, m := range msgs {
+		if m == nil {
+			return nil, fmt.Errorf("message %d; %w", i, ErrNilMessage)
+		}
+
+		d := m.ProtoReflect().Descriptor()
+		if md == nil {
+			md = d
+			continue
+		}
+		if d.FullName() != md.FullName() {
+			return nil, fmt.Errorf(
+				"%s and %s; %w", md.FullName(), d.FullName(), ErrMixedMessageTypes,
+			)
+		}
+	}
+
+	return md, nil
+}
+
+// fieldKind classifies a (non-map) field. Enums export as their value name
+// and protobuf wrapper types export as the value they wrap.
+func fieldKind(fd protoreflect.FieldDescriptor) kind {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return kindBool
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind,
+		protoreflect.Sfixed32Kind:
+		return kindInt32
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed64Kind:
+		return kindInt64
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return kindUint32
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return kindUint64
+	case protoreflect.FloatKind:
+		return kindFloat
+	case protoreflect.DoubleKind:
+		return kindDouble
+	case protoreflect.StringKind, protoreflect.EnumKind:
+		return kindString
+	case protoreflect.BytesKind:
+		return kindBytes
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageKind(fd.Message())
+	}
+
+	return kindString
+}
+
+func messageKind(md protoreflect.MessageDescriptor) kind {
+	switch name := md.FullName(); {
+	case name == timestampName:
+		return kindTimestamp
+	case name == durationName:
+		return kindDuration
+	case jsonMessages[name]:
+		return kindJSON
+	case wrapperMessages[name]:
+		return fieldKind(md.Fields().ByNumber(1))
+	}
+
+	return kindMessage
+}
+
+// nullable reports whether a singular field can be absent: proto3 optional
+// scalars and every message-typed field.
+func nullable(fd protoreflect.FieldDescriptor) bool {
+	return fd.HasPresence()
+}
+
+// leafValue returns the Go value of a singular leaf field: bool, int32,
+// int64, uint32, uint64, float32, float64, string, []byte, time.Time,
+// time.Duration, or a JSON string for kindJSON. The second result is false
+// when the field is absent or m is nil.
+func leafValue(
+	m protoreflect.Message, fd protoreflect.FieldDescriptor,
+) (any, bool, error) {
+	if m == nil || !m.IsValid() || (nullable(fd) && !m.Has(fd)) {
+		return nil, false, nil
+	}
+
+	return elementValue(fd, m.Get(fd))
+}
+
+// elementValue converts one value of fd, which may be an element of a
+// repeated field, into the Go value documented on leafValue.
+func elementValue(
+	fd protoreflect.FieldDescriptor, v protoreflect.Value,
+) (any, bool, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v.Bool(), true, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind,
+		protoreflect.Sfixed32Kind:
+		return int32(v.Int()), true, nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed64Kind:
+		return v.Int(), true, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return uint32(v.Uint()), true, nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return v.Uint(), true, nil
+	case protoreflect.FloatKind:
+		return float32(v.Float()), true, nil
+	case protoreflect.DoubleKind:
+		return v.Float(), true, nil
+	case protoreflect.StringKind:
+		return v.String(), true, nil
+	case protoreflect.BytesKind:
+		return v.Bytes(), true, nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name()), true, nil
+		}
+		return fmt.Sprint(int32(v.Enum())), true, nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageValue(v.Message())
+	}
+
+	return nil, false, nil
+}
+
+func messageValue(m protoreflect.Message) (any, bool, error) {
+	md := m.Descriptor()
+	switch name := md.FullName(); {
+	case name == timestampName:
+		seconds := m.Get(md.Fields().ByName("seconds")).Int()
+		nanos := m.Get(md.Fields().ByName("nanos")).Int()
+		return time.Unix(seconds, nanos).UTC(), true, nil
+	case name == durationName:
+		seconds := m.Get(md.Fields().ByName("seconds")).Int()
+		nanos := m.Get(md.Fields().ByName("nanos")).Int()
+		d := time.Duration(seconds)*time.Second + time.Duration(nanos)
+		return d, true, nil
+	case jsonMessages[name]:
+		s, err := marshalJSON(m.Interface())
+		if err != nil {
+			return nil, false, err
+		}
+		return s, true, nil
+	case wrapperMessages[name]:
+		inner := md.Fields().ByNumber(1)
+		return elementValue(inner, m.Get(inner))
+	}
+
+	return nil, false, nil
+}
+
+// marshalJSON encodes m as compact JSON. protojson deliberately varies its
+// whitespace between builds, so the output is compacted to keep exports
+// stable.
+func marshalJSON(m proto.Message) (string, error) {
+	b, err := jsonMarshaller.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal %s; %w",
+			m.ProtoReflect().Descriptor().FullName(), err)
+	}
+
+	var buf bytes.Buffer
+	if err = json.Compact(&buf, b); err != nil {
+		return "", fmt.Errorf("could not compact json; %w", err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,201 @@
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/apache/arrow-go/v18/parquet/schema"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Compression selects the Parquet page compression codec.
+type Compression int
+
+const (
+	// CompressionSnappy is the default codec.
+	CompressionSnappy Compression = iota
+	// CompressionZstd trades write speed for smaller files.
+	CompressionZstd
+	// CompressionGzip is the most widely supported codec.
+	CompressionGzip
+	// CompressionNone disables compression.
+	CompressionNone
+)
+
+const defaultRowGroupSize = 64 * 1024
+
+var codecs = map[Compression]compress.Compression{
+	CompressionSnappy: compress.Codecs.Snappy,
+	CompressionZstd:   compress.Codecs.Zstd,
+	CompressionGzip:   compress.Codecs.Gzip,
+	CompressionNone:   compress.Codecs.Uncompressed,
+}
+
+// ParquetOption configures a ParquetWriter.
+type ParquetOption func(*parquetOptions)
+
+type parquetOptions struct {
+	rowGroupSize int64
+	compression  Compression
+}
+
+// WithRowGroupSize sets the number of rows buffered into each row group.
+// The default is 65536.
+func WithRowGroupSize(rows int64) ParquetOption {
+	return func(o *parquetOptions) {
+		o.rowGroupSize = rows
+	}
+}
+
+// WithCompression sets the page compression codec. The default is
+// CompressionSnappy.
+func WithCompression(codec Compression) ParquetOption {
+	return func(o *parquetOptions) {
+		o.compression = codec
+	}
+}
+
+// ParquetSchema returns the Parquet schema for md. Nested messages such as
+// AdvancedSeasonStatSide become optional groups, repeated fields become
+// LIST columns, proto3 optional and wrapper fields become optional columns
+// and timestamps become UTC microsecond timestamps.
+func ParquetSchema(md protoreflect.MessageDescriptor) (*schema.Schema, error) {
+	sc, err := pqarrow.ToParquet(
+		ArrowSchema(md),
+		parquet.NewWriterProperties(),
+		pqarrow.DefaultWriterProps(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not derive parquet schema for %s; %w",
+			md.FullName(), err)
+	}
+
+	return sc, nil
+}
+
+// ParquetWriter streams messages of a single type to a Parquet file,
+// flushing a row group each time the configured row group size is reached.
+type ParquetWriter struct {
+	rowGroupSize int64
+	builder      *RecordBuilder
+	writer       *pqarrow.FileWriter
+}
+
+// NewParquetWriter creates a ParquetWriter for messages described by md,
+// e.g. (&cfbd.Play{}).ProtoReflect().Descriptor(). Close must be called to
+// write the file footer.
+func NewParquetWriter(
+	w io.Writer, md protoreflect.MessageDescriptor, opts ...ParquetOption,
+) (*ParquetWriter, error) {
+	options := parquetOptions{
+		rowGroupSize: defaultRowGroupSize,
+		compression:  CompressionSnappy,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.rowGroupSize < 1 {
+		return nil, fmt.Errorf(
+			"row group size must be positive, got %d", options.rowGroupSize,
+		)
+	}
+	codec, ok := codecs[options.compression]
+	if !ok {
+		return nil, fmt.Errorf("unknown compression %d", options.compression)
+	}
+
+	builder := NewRecordBuilder(md, memory.DefaultAllocator)
+	props := parquet.NewWriterProperties(
+		parquet.WithCompression(codec),
+		parquet.WithMaxRowGroupLength(options.rowGroupSize),
+	)
+	writer, err := pqarrow.NewFileWriter(
+		builder.Schema(), w, props,
+		pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()),
+	)
+	if err != nil {
+		builder.Release()
+		return nil, fmt.Errorf("could not create parquet writer for %s; %w",
+			md.FullName(), err)
+	}
+
+	return &ParquetWriter{
+		rowGroupSize: options.rowGroupSize,
+		builder:      builder,
+		writer:       writer,
+	}, nil
+}
+
+// Write appends msgs, which must match the writer's message type.
+func (w *ParquetWriter) Write(msgs ...proto.Message) error {
+	for _, m := range msgs {
+		if err := w.builder.Append(m); err != nil {
+			return err
+		}
+		if int64(w.builder.Len()) >= w.rowGroupSize {
+			if err := w.flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Close flushes buffered rows and writes the file footer. It does not close
+// the underlying io.Writer.
+func (w *ParquetWriter) Close() error {
+	defer w.builder.Release()
+	if err := w.flush(); err != nil {
+		return err
+	}
+	if err := w.writer.Close(); err != nil {
+		return fmt.Errorf("could not close parquet writer; %w", err)
+	}
+
+	return nil
+}
+
+func (w *ParquetWriter) flush() error {
+	if w.builder.Len() == 0 {
+		return nil
+	}
+
+	record := w.builder.NewRecordBatch()
+	defer record.Release()
+	if err := w.writer.Write(record); err != nil {
+		return fmt.Errorf("could not write parquet row group; %w", err)
+	}
+
+	return nil
+}
+
+// WriteParquet writes msgs to w as a complete Parquet file. Every message
+// must be of the same type. Nothing is written when msgs is empty.
+func WriteParquet(
+	w io.Writer, msgs []proto.Message, opts ...ParquetOption,
+) error {
+	md, err := descriptorOf(msgs)
+	if err != nil {
+		return err
+	}
+	if md == nil {
+		return nil
+	}
+
+	writer, err := NewParquetWriter(w, md, opts...)
+	if err != nil {
+		return err
+	}
+	if err = writer.Write(msgs...); err != nil {
+		_ = writer.Close()
+		return err
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,162 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readParquet reads a Parquet file back into a single record.
+func readParquet(t *testing.T, b []byte) arrow.RecordBatch {
+	tbl, err := pqarrow.ReadTable(
+		context.Background(),
+		bytes.NewReader(b),
+		parquet.NewReaderProperties(memory.DefaultAllocator),
+		pqarrow.ArrowReadProperties{},
+		memory.DefaultAllocator,
+	)
+	require.NoError(t, err)
+	t.Cleanup(tbl.Release)
+
+	reader := array.NewTableReader(tbl, tbl.NumRows())
+	t.Cleanup(reader.Release)
+	require.True(t, reader.Next())
+	return reader.RecordBatch()
+}
+
+func column(t *testing.T, rec arrow.RecordBatch, name string) arrow.Array {
+	indices := rec.Schema().FieldIndices(name)
+	require.Len(t, indices, 1, "column %s", name)
+	return rec.Column(indices[0])
+}
+
+func TestParquetSchema_ShouldMapDescriptor(t *testing.T) {
+	sc, err := ParquetSchema((&cfbd.AdvancedSeasonStat{}).ProtoReflect().Descriptor())
+	require.NoError(t, err)
+
+	ppa := sc.Column(sc.ColumnIndexByName("offense.passing_plays.ppa"))
+	assert.Equal(t, int16(3), ppa.MaxDefinitionLevel())
+	assert.Equal(t, parquet.Types.Double, ppa.PhysicalType())
+
+	season := sc.Column(sc.ColumnIndexByName("season"))
+	assert.Equal(t, int16(0), season.MaxDefinitionLevel())
+
+	games, err := ParquetSchema((&cfbd.Game{}).ProtoReflect().Descriptor())
+	require.NoError(t, err)
+	scores := games.Column(games.ColumnIndexByName("home_line_scores.list.element"))
+	assert.Equal(t, int16(1), scores.MaxRepetitionLevel())
+	attendance := games.Column(games.ColumnIndexByName("attendance"))
+	assert.Equal(t, int16(1), attendance.MaxDefinitionLevel())
+}
+
+func TestWriteParquet_Games_ShouldRoundTrip(t *testing.T) {
+	games := fixture.Load(t, "games.json", func() *cfbd.Game { return &cfbd.Game{} })
+	var buf bytes.Buffer
+
+	require.NoError(t, WriteParquet(&buf, Messages(games)))
+
+	rec := readParquet(t, buf.Bytes())
+	require.Equal(t, int64(len(games)), rec.NumRows())
+	ids := column(t, rec, "id").(*array.Int32)
+	starts := column(t, rec, "start_date").(*array.Timestamp)
+	attendance := column(t, rec, "attendance").(*array.Int32)
+	scores := column(t, rec, "home_line_scores").(*array.List)
+	scoreValues := scores.ListValues().(*array.Int32)
+	for i, game := range games {
+		assert.Equal(t, game.GetId(), ids.Value(i))
+		assert.Equal(t, game.GetStartDate().AsTime().UnixMicro(), int64(starts.Value(i)))
+		assert.Equal(t, game.Attendance == nil, attendance.IsNull(i))
+		if game.Attendance != nil {
+			assert.Equal(t, game.GetAttendance(), attendance.Value(i))
+		}
+
+		start, end := scores.ValueOffsets(i)
+		got := make([]int32, 0, end-start)
+		for j := start; j < end; j++ {
+			got = append(got, scoreValues.Value(int(j)))
+		}
+		assert.Equal(t, len(game.GetHomeLineScores()), len(got))
+		for j, v := range game.GetHomeLineScores() {
+			assert.Equal(t, v, got[j])
+		}
+	}
+}
+
+func TestWriteParquet_NestedGroups_ShouldRoundTrip(t *testing.T) {
+	stats := fixture.Load(t, "stat_season_advanced.json",
+		func() *cfbd.AdvancedSeasonStat { return &cfbd.AdvancedSeasonStat{} })
+	stats = append(stats, &cfbd.AdvancedSeasonStat{Team: "No Offense"})
+	var buf bytes.Buffer
+
+	require.NoError(t, WriteParquet(&buf, Messages(stats), WithCompression(CompressionZstd)))
+
+	rec := readParquet(t, buf.Bytes())
+	offense := column(t, rec, "offense").(*array.Struct)
+	passing := offense.Field(0).(*array.Struct)
+	ppa := passing.Field(3).(*array.Float64)
+	teams := column(t, rec, "team").(*array.String)
+	for i, stat := range stats {
+		assert.Equal(t, stat.GetTeam(), teams.Value(i))
+		assert.Equal(t, stat.Offense == nil, offense.IsNull(i))
+		want := stat.GetOffense().GetPassingPlays()
+		if want != nil && want.Ppa != nil {
+			assert.InDelta(t, want.GetPpa(), ppa.Value(i), 1e-12)
+		}
+	}
+}
+
+func TestParquetWriter_RowGroupSize_ShouldSplitRowGroups(t *testing.T) {
+	plays := fixture.Load(t, "plays.json", func() *cfbd.Play { return &cfbd.Play{} })
+	require.Greater(t, len(plays), 1)
+	var buf bytes.Buffer
+
+	writer, err := NewParquetWriter(
+		&buf, (&cfbd.Play{}).ProtoReflect().Descriptor(), WithRowGroupSize(1),
+	)
+	require.NoError(t, err)
+	for _, play := range plays {
+		require.NoError(t, writer.Write(play))
+	}
+	require.NoError(t, writer.Close())
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	defer reader.Close()
+	assert.Equal(t, len(plays), reader.NumRowGroups())
+	assert.Equal(t, int64(len(plays)), reader.NumRows())
+
+	rec := readParquet(t, buf.Bytes())
+	ids := column(t, rec, "id").(*array.String)
+	for i, play := range plays {
+		assert.Equal(t, play.GetId(), ids.Value(i))
+	}
+}
+
+func TestWriteParquet_UnencodableValue_ShouldFail(t *testing.T) {
+	var buf bytes.Buffer
+
+	assert.Error(t, WriteParquet(&buf, unencodable()))
+}
+
+func TestParquetWriter_WrongType_ShouldFail(t *testing.T) {
+	writer, err := NewParquetWriter(
+		&bytes.Buffer{}, (&cfbd.Play{}).ProtoReflect().Descriptor(),
+	)
+	require.NoError(t, err)
+
+	err = writer.Write(&cfbd.Game{})
+
+	assert.ErrorIs(t, err, ErrMixedMessageTypes)
+	require.NoError(t, writer.Close())
+}
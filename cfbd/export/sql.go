@@ -0,0 +1,775 @@
+package export
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Dialect selects the SQL flavor of generated statements. Upserts use
+// INSERT ... ON CONFLICT, so only SQLite and PostgreSQL are supported;
+// MySQL and SQL Server reject the statements.
+type Dialect int
+
+const (
+	// DialectSQLite is the default dialect.
+	DialectSQLite Dialect = iota
+	// DialectPostgres uses $n placeholders and PostgreSQL column types.
+	DialectPostgres
+)
+
+// rowKeyColumn is the primary key of root tables whose message has no
+// natural key. It holds a digest of the message, so loading the same
+// message twice is idempotent.
+const rowKeyColumn = "row_key"
+
+var sqlTypes = map[Dialect]map[kind]string{
+	DialectSQLite: {
+		kindBool:      "BOOLEAN",
+		kindInt32:     "INTEGER",
+		kindInt64:     "BIGINT",
+		kindUint32:    "BIGINT",
+		kindUint64:    "BIGINT",
+		kindFloat:     "REAL",
+		kindDouble:    "REAL",
+		kindString:    "TEXT",
+		kindBytes:     "BLOB",
+		kindTimestamp: "TIMESTAMP",
+		kindDuration:  "BIGINT",
+		kindJSON:      "TEXT",
+	},
+	DialectPostgres: {
+		kindBool:      "BOOLEAN",
+		kindInt32:     "INTEGER",
+		kindInt64:     "BIGINT",
+		kindUint32:    "BIGINT",
+		kindUint64:    "BIGINT",
+		kindFloat:     "REAL",
+		kindDouble:    "DOUBLE PRECISION",
+		kindString:    "TEXT",
+		kindBytes:     "BYTEA",
+		kindTimestamp: "TIMESTAMPTZ",
+		kindDuration:  "BIGINT",
+		kindJSON:      "JSONB",
+	},
+}
+
+func (d Dialect) placeholder(n int) string {
+	if d == DialectPostgres {
+		return fmt.Sprintf("$%d", n)
+	}
+
+	return "?"
+}
+
+// SQLOption configures a SQLSchema.
+type SQLOption func(*sqlOptions)
+
+type sqlOptions struct {
+	dialect    Dialect
+	table      string
+	primaryKey []string
+}
+
+// WithDialect sets the SQL dialect. The default is DialectSQLite.
+func WithDialect(dialect Dialect) SQLOption {
+	return func(o *sqlOptions) {
+		o.dialect = dialect
+	}
+}
+
+// WithTableName overrides the root table name, which defaults to the snake
+// cased message name, e.g. team_sp for TeamSP. Child tables are named after
+// the root table.
+func WithTableName(name string) SQLOption {
+	return func(o *sqlOptions) {
+		o.table = name
+	}
+}
+
+// WithPrimaryKey sets the top-level fields forming the root table's primary
+// key. By default a field named id is used when present, and a row_key
+// digest of the message otherwise.
+func WithPrimaryKey(fields ...string) SQLOption {
+	return func(o *sqlOptions) {
+		o.primaryKey = fields
+	}
+}
+
+// Table describes one generated table.
+type Table struct {
+	Name       string
+	Columns    []Column
+	PrimaryKey []string
+	// Parent is the table a child table's rows belong to, and ForeignKey
+	// the columns referencing the parent's primary key. Both are empty for
+	// the root table.
+	Parent     string
+	ForeignKey []string
+}
+
+// Column describes one column of a Table.
+type Column struct {
+	Name     string
+	Type     string
+	Nullable bool
+}
+
+// sqlColumn is a data column along with the field path, relative to its
+// table's row message, that it is read from.
+type sqlColumn struct {
+	Column
+	path []protoreflect.FieldDescriptor
+	// value marks the element column of a repeated scalar child table.
+	value bool
+	// json marks map fields and recursive messages stored as JSON.
+	json bool
+}
+
+type sqlTable struct {
+	name     string
+	parent   *sqlTable
+	keys     []Column
+	columns  []sqlColumn
+	children []*sqlTable
+	// path leads from the parent's row message to the repeated field whose
+	// elements are this table's rows.
+	path []protoreflect.FieldDescriptor
+	// pkFields are the root table's natural key fields; nil when rows are
+	// keyed by rowKeyColumn.
+	pkFields []protoreflect.FieldDescriptor
+}
+
+func (t *sqlTable) foreignKey() []Column {
+	if t.parent == nil {
+		return nil
+	}
+	return t.keys[:len(t.keys)-1]
+}
+
+// SQLSchema is a normalized relational schema derived from a message
+// descriptor, and loads messages into it through a database/sql driver for
+// SQLite or PostgreSQL.
+//
+// Scalar fields become columns of the message's table and nested messages
+// such as TeamSP.offense are flattened into column groups (offense_rating,
+// offense_success, ...). Repeated fields become child tables keyed by the
+// parent's primary key and the element index, so Game.home_line_scores is
+// stored in game_home_line_scores(game_id, home_line_scores_index, value).
+// Map fields and self-referencing messages are stored as JSON.
+type SQLSchema struct {
+	dialect Dialect
+	md      protoreflect.MessageDescriptor
+	root    *sqlTable
+	// tables holds every table in parent-before-child order.
+	tables []*sqlTable
+}
+
+// NewSQLSchema derives the schema for messages described by md, e.g.
+// (&cfbd.Game{}).ProtoReflect().Descriptor().
+func NewSQLSchema(
+	md protoreflect.MessageDescriptor, opts ...SQLOption,
+) (*SQLSchema, error) {
+	var options sqlOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if _, ok := sqlTypes[options.dialect]; !ok {
+		return nil, fmt.Errorf("unknown dialect %d", options.dialect)
+	}
+
+	s := &SQLSchema{dialect: options.dialect, md: md}
+	root := &sqlTable{name: options.table}
+	if root.name == "" {
+		root.name = snakeCase(string(md.Name()))
+	}
+
+	pk := options.primaryKey
+	if pk == nil && md.Fields().ByName("id") != nil {
+		pk = []string{"id"}
+	}
+	for _, name := range pk {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.IsList() || fd.IsMap() ||
+			fieldKind(fd) == kindMessage {
+			return nil, fmt.Errorf("%s has no scalar field %q for a primary key",
+				md.FullName(), name)
+		}
+		root.pkFields = append(root.pkFields, fd)
+		root.keys = append(root.keys, Column{
+			Name: name, Type: s.sqlType(fieldKind(fd)),
+		})
+	}
+	if root.pkFields == nil {
+		root.keys = []Column{{Name: rowKeyColumn, Type: s.sqlType(kindString)}}
+	}
+
+	s.root = root
+	if err := s.addTable(root, md); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *SQLSchema) sqlType(k kind) string {
+	return sqlTypes[s.dialect][k]
+}
+
+// addTable adds t and its columns for row messages described by md.
+func (s *SQLSchema) addTable(
+	t *sqlTable, md protoreflect.MessageDescriptor,
+) error {
+	s.tables = append(s.tables, t)
+	if md != nil {
+		seen := map[protoreflect.FullName]bool{md.FullName(): true}
+		if err := s.addFields(t, md, "", nil, false, seen); err != nil {
+			return err
+		}
+	}
+
+	names := make(map[string]bool)
+	for _, c := range t.keys {
+		names[c.Name] = true
+	}
+	for _, c := range t.columns {
+		if names[c.Name] {
+			return fmt.Errorf("table %s has two columns named %s",
+				t.name, c.Name)
+		}
+		names[c.Name] = true
+	}
+
+	return nil
+}
+
+func (s *SQLSchema) addFields(
+	t *sqlTable,
+	md protoreflect.MessageDescriptor,
+	prefix string,
+	path []protoreflect.FieldDescriptor,
+	inGroup bool,
+	seen map[protoreflect.FullName]bool,
+) error {
+	fields := md.Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if path == nil && slices.Contains(t.pkFields, fd) {
+			continue
+		}
+		name := prefix + string(fd.Name())
+		fieldPath := append(slices.Clone(path), fd)
+
+		switch k := fieldKind(fd); {
+		case fd.IsMap():
+			t.columns = append(t.columns, s.jsonColumn(name, fieldPath))
+		case fd.IsList():
+			if err := s.addChild(t, name, fieldPath, fd); err != nil {
+				return err
+			}
+		case k == kindMessage && seen[fd.Message().FullName()]:
+			t.columns = append(t.columns, s.jsonColumn(name, fieldPath))
+		case k == kindMessage:
+			nested := maps.Clone(seen)
+			nested[fd.Message().FullName()] = true
+			if err := s.addFields(
+				t, fd.Message(), name+"_", fieldPath, true, nested,
+			); err != nil {
+				return err
+			}
+		default:
+			t.columns = append(t.columns, sqlColumn{
+				Column: Column{
+					Name:     name,
+					Type:     s.sqlType(k),
+					Nullable: inGroup || nullable(fd),
+				},
+				path: fieldPath,
+			})
+		}
+	}
+
+	return nil
+}
+
+func (s *SQLSchema) jsonColumn(
+	name string, path []protoreflect.FieldDescriptor,
+) sqlColumn {
+	return sqlColumn{
+		Column: Column{Name: name, Type: s.sqlType(kindJSON), Nullable: true},
+		path:   path,
+		json:   true,
+	}
+}
+
+// addChild adds the child table holding the elements of the repeated field
+// fd, reached from parent's row message through path.
+func (s *SQLSchema) addChild(
+	parent *sqlTable,
+	name string,
+	path []protoreflect.FieldDescriptor,
+	fd protoreflect.FieldDescriptor,
+) error {
+	child := &sqlTable{
+		name:   parent.name + "_" + name,
+		parent: parent,
+		path:   path,
+	}
+	for _, c := range parent.keys {
+		if parent.parent == nil {
+			c.Name = parent.name + "_" + c.Name
+		}
+		child.keys = append(child.keys, c)
+	}
+	child.keys = append(child.keys, Column{
+		Name: name + "_index", Type: s.sqlType(kindInt32),
+	})
+	parent.children = append(parent.children, child)
+
+	if k := fieldKind(fd); k != kindMessage {
+		child.columns = []sqlColumn{{
+			Column: Column{Name: "value", Type: s.sqlType(k)},
+			value:  true,
+		}}
+		return s.addTable(child, nil)
+	}
+
+	return s.addTable(child, fd.Message())
+}
+
+// Tables describes the generated tables, parents before children.
+func (s *SQLSchema) Tables() []Table {
+	out := make([]Table, 0, len(s.tables))
+	for _, t := range s.tables {
+		table := Table{Name: t.name}
+		for _, c := range t.keys {
+			table.Columns = append(table.Columns, c)
+			table.PrimaryKey = append(table.PrimaryKey, c.Name)
+		}
+		for _, c := range t.columns {
+			table.Columns = append(table.Columns, c.Column)
+		}
+		if t.parent != nil {
+			table.Parent = t.parent.name
+			for _, c := range t.foreignKey() {
+				table.ForeignKey = append(table.ForeignKey, c.Name)
+			}
+		}
+		out = append(out, table)
+	}
+
+	return out
+}
+
+// CreateStatements returns a CREATE TABLE IF NOT EXISTS statement per
+// table, parents before children.
+func (s *SQLSchema) CreateStatements() []string {
+	out := make([]string, 0, len(s.tables))
+	for _, t := range s.Tables() {
+		var b strings.Builder
+		fmt.Fprintf(&b, "CREATE TABLE IF NOT EXISTS %s (\n", quoteIdent(t.Name))
+		for _, c := range t.Columns {
+			fmt.Fprintf(&b, "\t%s %s", quoteIdent(c.Name), c.Type)
+			if !c.Nullable {
+				b.WriteString(" NOT NULL")
+			}
+			b.WriteString(",\n")
+		}
+		fmt.Fprintf(&b, "\tPRIMARY KEY (%s)", quoteIdents(t.PrimaryKey))
+		if t.Parent != "" {
+			parentKey := s.table(t.Parent).keys
+			names := make([]string, len(parentKey))
+			for i, c := range parentKey {
+				names[i] = c.Name
+			}
+			fmt.Fprintf(&b,
+				",\n\tFOREIGN KEY (%s) REFERENCES %s (%s) ON DELETE CASCADE",
+				quoteIdents(t.ForeignKey), quoteIdent(t.Parent),
+				quoteIdents(names))
+		}
+		b.WriteString("\n)")
+		out = append(out, b.String())
+	}
+
+	return out
+}
+
+func (s *SQLSchema) table(name string) *sqlTable {
+	for _, t := range s.tables {
+		if t.name == name {
+			return t
+		}
+	}
+
+	return nil
+}
+
+// Create creates any missing tables.
+func (s *SQLSchema) Create(ctx context.Context, db *sql.DB) error {
+	for _, stmt := range s.CreateStatements() {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("could not create tables for %s; %w",
+				s.md.FullName(), err)
+		}
+	}
+
+	return nil
+}
+
+// Upsert inserts msgs, replacing rows with the same primary key, in a
+// single transaction. The child rows of a replaced message are deleted and
+// rewritten, so shrinking lists leave no stale rows behind. The database
+// must accept the schema's dialect.
+func (s *SQLSchema) Upsert(
+	ctx context.Context, db *sql.DB, msgs ...proto.Message,
+) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("could not begin transaction; %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	l := &sqlLoader{schema: s, tx: tx, stmts: make(map[string]*sql.Stmt)}
+	defer l.close()
+	for i, m := range msgs {
+		if m == nil {
+			return fmt.Errorf("message %d; %w", i, ErrNilMessage)
+		}
+		name := m.ProtoReflect().Descriptor().FullName()
+		if name != s.md.FullName() {
+			return fmt.Errorf("%s and %s; %w",
+				s.md.FullName(), name, ErrMixedMessageTypes)
+		}
+		if err = l.upsert(ctx, m.ProtoReflect()); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("could not commit %s; %w", s.md.FullName(), err)
+	}
+
+	return nil
+}
+
+// UpsertSQL creates the tables for msgs if needed and upserts msgs into
+// them. Every message must be of the same type. Nothing is written when
+// msgs is empty.
+func UpsertSQL(
+	ctx context.Context, db *sql.DB, msgs []proto.Message, opts ...SQLOption,
+) error {
+	md, err := descriptorOf(msgs)
+	if err != nil {
+		return err
+	}
+	if md == nil {
+		return nil
+	}
+
+	s, err := NewSQLSchema(md, opts...)
+	if err != nil {
+		return err
+	}
+	if err = s.Create(ctx, db); err != nil {
+		return err
+	}
+
+	return s.Upsert(ctx, db, msgs...)
+}
+
+type sqlLoader struct {
+	schema *SQLSchema
+	tx     *sql.Tx
+	stmts  map[string]*sql.Stmt
+}
+
+func (l *sqlLoader) close() {
+	for _, stmt := range l.stmts {
+		_ = stmt.Close()
+	}
+}
+
+func (l *sqlLoader) exec(ctx context.Context, query string, args []any) error {
+	stmt, ok := l.stmts[query]
+	if !ok {
+		var err error
+		if stmt, err = l.tx.PrepareContext(ctx, query); err != nil {
+			return fmt.Errorf("could not prepare %q; %w", query, err)
+		}
+		l.stmts[query] = stmt
+	}
+	if _, err := stmt.ExecContext(ctx, args...); err != nil {
+		return fmt.Errorf("could not execute %q; %w", query, err)
+	}
+
+	return nil
+}
+
+func (l *sqlLoader) upsert(ctx context.Context, m protoreflect.Message) error {
+	root := l.schema.root
+	keys, err := rootKey(root, m)
+	if err != nil {
+		return err
+	}
+
+	row, err := rowValues(root, m)
+	if err != nil {
+		return err
+	}
+	err = l.exec(ctx, l.schema.upsertQuery(root), append(keys, row...))
+	if err != nil {
+		return err
+	}
+
+	// Delete grandchildren before children so foreign keys hold.
+	for _, t := range slices.Backward(l.schema.tables) {
+		if t == root {
+			continue
+		}
+		if err = l.exec(ctx, l.schema.deleteQuery(t), keys); err != nil {
+			return err
+		}
+	}
+
+	return l.insertChildren(ctx, root, m, keys)
+}
+
+func (l *sqlLoader) insertChildren(
+	ctx context.Context, t *sqlTable, m protoreflect.Message, keys []any,
+) error {
+	for _, child := range t.children {
+		list, ok := listAt(m, child.path)
+		if !ok {
+			continue
+		}
+
+		for i := range list.Len() {
+			elemKeys := append(slices.Clone(keys), int32(i))
+			fd := child.path[len(child.path)-1]
+
+			var elem protoreflect.Message
+			var row []any
+			if child.columns[0].value {
+				arg, err := sqlArg(elementValue(fd, list.Get(i)))
+				if err != nil {
+					return err
+				}
+				row = []any{arg}
+			} else {
+				elem = list.Get(i).Message()
+				var err error
+				if row, err = rowValues(child, elem); err != nil {
+					return err
+				}
+			}
+
+			if err := l.exec(
+				ctx, l.schema.insertQuery(child), append(elemKeys, row...),
+			); err != nil {
+				return err
+			}
+			if elem != nil {
+				err := l.insertChildren(ctx, child, elem, elemKeys)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// rootKey returns the primary key values of a root row.
+func rootKey(t *sqlTable, m protoreflect.Message) ([]any, error) {
+	if t.pkFields == nil {
+		b, err := proto.MarshalOptions{Deterministic: true}.
+			Marshal(m.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("could not key %s; %w",
+				m.Descriptor().FullName(), err)
+		}
+		sum := sha256.Sum256(b)
+		return []any{hex.EncodeToString(sum[:16])}, nil
+	}
+
+	keys := make([]any, 0, len(t.pkFields))
+	for _, fd := range t.pkFields {
+		key, err := sqlArg(leafValue(m, fd))
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func rowValues(t *sqlTable, m protoreflect.Message) ([]any, error) {
+	row := make([]any, 0, len(t.columns))
+	for _, c := range t.columns {
+		v, err := columnValue(c, m)
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, v)
+	}
+
+	return row, nil
+}
+
+// columnValue reads c from m, returning nil when a message along its path
+// is absent.
+func columnValue(c sqlColumn, m protoreflect.Message) (any, error) {
+	for _, fd := range c.path[:len(c.path)-1] {
+		if !m.Has(fd) {
+			return nil, nil
+		}
+		m = m.Get(fd).Message()
+	}
+
+	fd := c.path[len(c.path)-1]
+	switch {
+	case fd.IsMap():
+		return mapJSON(fd, m.Get(fd).Map())
+	case c.json:
+		if !m.Has(fd) {
+			return nil, nil
+		}
+		return marshalJSON(m.Get(fd).Message().Interface())
+	}
+
+	return sqlArg(leafValue(m, fd))
+}
+
+// listAt follows path from m to a repeated field. The second result is false
+// when a message along the path is absent.
+func listAt(
+	m protoreflect.Message, path []protoreflect.FieldDescriptor,
+) (protoreflect.List, bool) {
+	for _, fd := range path[:len(path)-1] {
+		if !m.Has(fd) {
+			return nil, false
+		}
+		m = m.Get(fd).Message()
+	}
+
+	return m.Get(path[len(path)-1]).List(), true
+}
+
+// sqlArg converts a leafValue result into a database/sql argument, passing
+// on its error.
+func sqlArg(v any, ok bool, err error) (any, error) {
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	switch v := v.(type) {
+	case float32:
+		return float64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return fmt.Sprint(v), nil
+		}
+		return int64(v), nil
+	case time.Duration:
+		return int64(v), nil
+	}
+
+	return v, nil
+}
+
+func (s *SQLSchema) upsertQuery(t *sqlTable) string {
+	query := s.insertQuery(t)
+	keys := make([]string, len(t.keys))
+	for i, c := range t.keys {
+		keys[i] = c.Name
+	}
+	if len(t.columns) == 0 {
+		return query + " ON CONFLICT (" + quoteIdents(keys) + ") DO NOTHING"
+	}
+
+	sets := make([]string, len(t.columns))
+	for i, c := range t.columns {
+		sets[i] = quoteIdent(c.Name) + " = excluded." + quoteIdent(c.Name)
+	}
+
+	return query + " ON CONFLICT (" + quoteIdents(keys) + ") DO UPDATE SET " +
+		strings.Join(sets, ", ")
+}
+
+func (s *SQLSchema) insertQuery(t *sqlTable) string {
+	names := make([]string, 0, len(t.keys)+len(t.columns))
+	for _, c := range t.keys {
+		names = append(names, c.Name)
+	}
+	for _, c := range t.columns {
+		names = append(names, c.Name)
+	}
+	params := make([]string, len(names))
+	for i := range names {
+		params[i] = s.dialect.placeholder(i + 1)
+	}
+
+	return "INSERT INTO " + quoteIdent(t.name) + " (" + quoteIdents(names) +
+		") VALUES (" + strings.Join(params, ", ") + ")"
+}
+
+// deleteQuery deletes every row of t belonging to one root row. Every
+// descendant table starts with the root key columns.
+func (s *SQLSchema) deleteQuery(t *sqlTable) string {
+	conds := make([]string, len(s.root.keys))
+	for i := range s.root.keys {
+		conds[i] = quoteIdent(t.keys[i].Name) + " = " +
+			s.dialect.placeholder(i+1)
+	}
+
+	return "DELETE FROM " + quoteIdent(t.name) + " WHERE " +
+		strings.Join(conds, " AND ")
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func quoteIdents(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = quoteIdent(name)
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// snakeCase converts a message name such as TeamSP or GameTeamStats into
+// team_sp or game_team_stats.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) ||
+				(unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
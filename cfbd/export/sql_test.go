@@ -0,0 +1,253 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	_ "modernc.org/sqlite"
+)
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite", ":memory:")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+func tableByName(t *testing.T, s *SQLSchema, name string) Table {
+	t.Helper()
+	for _, table := range s.Tables() {
+		if table.Name == name {
+			return table
+		}
+	}
+	require.Failf(t, "missing table", "no table %s", name)
+
+	return Table{}
+}
+
+func columnByName(t *testing.T, table Table, name string) Column {
+	t.Helper()
+	for _, c := range table.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	require.Failf(t, "missing column", "no column %s.%s", table.Name, name)
+
+	return Column{}
+}
+
+func TestNewSQLSchema_Game_ShouldKeyByIDAndSplitRepeatedFields(t *testing.T) {
+	s, err := NewSQLSchema((&cfbd.Game{}).ProtoReflect().Descriptor())
+	require.NoError(t, err)
+
+	game := tableByName(t, s, "game")
+	assert.Equal(t, []string{"id"}, game.PrimaryKey)
+	assert.Equal(t, Column{Name: "id", Type: "INTEGER"}, game.Columns[0])
+	assert.True(t, columnByName(t, game, "home_points").Nullable)
+	assert.False(t, columnByName(t, game, "completed").Nullable)
+	assert.Equal(t, "TIMESTAMP", columnByName(t, game, "start_date").Type)
+
+	scores := tableByName(t, s, "game_home_line_scores")
+	assert.Equal(t, "game", scores.Parent)
+	assert.Equal(t, []string{"game_id"}, scores.ForeignKey)
+	assert.Equal(t,
+		[]string{"game_id", "home_line_scores_index"}, scores.PrimaryKey)
+	assert.Equal(t, "INTEGER", columnByName(t, scores, "value").Type)
+}
+
+func TestNewSQLSchema_TeamSP_ShouldFlattenNestedColumnGroups(t *testing.T) {
+	s, err := NewSQLSchema((&cfbd.TeamSP{}).ProtoReflect().Descriptor())
+	require.NoError(t, err)
+
+	require.Len(t, s.Tables(), 1)
+	sp := tableByName(t, s, "team_sp")
+	assert.Equal(t, []string{rowKeyColumn}, sp.PrimaryKey)
+	assert.True(t, columnByName(t, sp, "offense_rating").Nullable)
+	assert.True(t, columnByName(t, sp, "defense_havoc_front_seven").Nullable)
+}
+
+func TestNewSQLSchema_WithPrimaryKey_ShouldUseNaturalKey(t *testing.T) {
+	s, err := NewSQLSchema(
+		(&cfbd.TeamSP{}).ProtoReflect().Descriptor(),
+		WithPrimaryKey("year", "team"),
+		WithTableName("sp_ratings"),
+		WithDialect(DialectPostgres),
+	)
+	require.NoError(t, err)
+
+	sp := tableByName(t, s, "sp_ratings")
+	assert.Equal(t, []string{"year", "team"}, sp.PrimaryKey)
+	assert.Equal(t, "DOUBLE PRECISION", columnByName(t, sp, "rating").Type)
+}
+
+func TestNewSQLSchema_UnknownPrimaryKey_ShouldError(t *testing.T) {
+	_, err := NewSQLSchema(
+		(&cfbd.TeamSP{}).ProtoReflect().Descriptor(),
+		WithPrimaryKey("offense"),
+	)
+
+	assert.ErrorContains(t, err, "offense")
+}
+
+func TestNewSQLSchema_GameTeamStats_ShouldNestChildTables(t *testing.T) {
+	s, err := NewSQLSchema((&cfbd.GameTeamStats{}).ProtoReflect().Descriptor())
+	require.NoError(t, err)
+
+	stats := tableByName(t, s, "game_team_stats_teams_stats")
+	assert.Equal(t, "game_team_stats_teams", stats.Parent)
+	assert.Equal(t,
+		[]string{"game_team_stats_id", "teams_index"}, stats.ForeignKey)
+	assert.Equal(t,
+		[]string{"game_team_stats_id", "teams_index", "stats_index"},
+		stats.PrimaryKey)
+
+	ddl := strings.Join(s.CreateStatements(), ";\n")
+	assert.Contains(t, ddl, `FOREIGN KEY ("game_team_stats_id", "teams_index") `+
+		`REFERENCES "game_team_stats_teams" ("game_team_stats_id", "teams_index")`)
+}
+
+func TestNewSQLSchema_AllMessages_ShouldCreateInSQLite(t *testing.T) {
+	db := openTestDB(t)
+	messages := cfbd.File_cfbd_internal_proto_cfbd_proto.Messages()
+	for i := range messages.Len() {
+		md := messages.Get(i)
+		s, err := NewSQLSchema(md)
+		require.NoError(t, err, md.FullName())
+		require.NoError(t, s.Create(context.Background(), db), md.FullName())
+	}
+}
+
+func TestUpsertSQL_Games_ShouldLoadRowsAndChildRows(t *testing.T) {
+	db := openTestDB(t)
+	games := fixture.Load(t, "games.json", func() *cfbd.Game { return &cfbd.Game{} })
+	require.NotEmpty(t, games)
+
+	require.NoError(t, UpsertSQL(context.Background(), db, Messages(games)))
+
+	var home string
+	var points sql.NullInt64
+	require.NoError(t, db.QueryRow(
+		`SELECT home_team, home_points FROM game WHERE id = ?`, games[0].GetId(),
+	).Scan(&home, &points))
+	assert.Equal(t, games[0].GetHomeTeam(), home)
+	assert.Equal(t, int64(games[0].GetHomePoints()), points.Int64)
+
+	rows, err := db.Query(`
+		SELECT value FROM game_home_line_scores
+		WHERE game_id = ? ORDER BY home_line_scores_index`,
+		games[0].GetId(),
+	)
+	require.NoError(t, err)
+	defer rows.Close()
+	var scores []int32
+	for rows.Next() {
+		var v int32
+		require.NoError(t, rows.Scan(&v))
+		scores = append(scores, v)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, games[0].GetHomeLineScores(), scores)
+}
+
+func TestUpsertSQL_Twice_ShouldReplaceRowsAndShrinkChildren(t *testing.T) {
+	db := openTestDB(t)
+	stats := fixture.Load(t, "games_teams.json",
+		func() *cfbd.GameTeamStats { return &cfbd.GameTeamStats{} })
+	require.NotEmpty(t, stats)
+	require.NoError(t, UpsertSQL(context.Background(), db, Messages(stats)))
+
+	count := func(table string) int {
+		var n int
+		require.NoError(t, db.QueryRow(
+			`SELECT COUNT(*) FROM `+quoteIdent(table)).Scan(&n))
+		return n
+	}
+	var want int
+	for _, team := range stats[0].GetTeams() {
+		want += len(team.GetStats())
+	}
+	assert.Equal(t, want, count("game_team_stats_teams_stats"))
+
+	updated := proto.Clone(stats[0]).(*cfbd.GameTeamStats)
+	updated.Teams = updated.Teams[:1]
+	updated.Teams[0].Stats = updated.Teams[0].Stats[:2]
+	updated.Teams[0].Stats[0].Stat = "99"
+	require.NoError(t, UpsertSQL(context.Background(), db,
+		[]proto.Message{updated}))
+
+	assert.Equal(t, 1, count("game_team_stats"))
+	assert.Equal(t, 1, count("game_team_stats_teams"))
+	assert.Equal(t, 2, count("game_team_stats_teams_stats"))
+
+	var stat string
+	require.NoError(t, db.QueryRow(`
+		SELECT stat FROM game_team_stats_teams_stats
+		WHERE game_team_stats_id = ? AND teams_index = 0 AND stats_index = 0`,
+		updated.GetId(),
+	).Scan(&stat))
+	assert.Equal(t, "99", stat)
+}
+
+func TestUpsertSQL_RowKey_ShouldBeIdempotent(t *testing.T) {
+	db := openTestDB(t)
+	ratings := fixture.Load(t, "ratings_sp.json",
+		func() *cfbd.TeamSP { return &cfbd.TeamSP{} })
+	require.NotEmpty(t, ratings)
+
+	for range 2 {
+		require.NoError(t, UpsertSQL(context.Background(), db,
+			Messages(ratings)))
+	}
+
+	var n int
+	var offense sql.NullFloat64
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM team_sp`).Scan(&n))
+	assert.Equal(t, len(ratings), n)
+	require.NoError(t, db.QueryRow(
+		`SELECT offense_rating FROM team_sp WHERE team = ?`,
+		ratings[0].GetTeam(),
+	).Scan(&offense))
+	assert.InDelta(t, ratings[0].GetOffense().GetRating(), offense.Float64, 1e-9)
+}
+
+func TestSQLSchema_Upsert_MixedTypes_ShouldError(t *testing.T) {
+	db := openTestDB(t)
+	s, err := NewSQLSchema((&cfbd.Game{}).ProtoReflect().Descriptor())
+	require.NoError(t, err)
+	require.NoError(t, s.Create(context.Background(), db))
+
+	err = s.Upsert(context.Background(), db, &cfbd.Game{Id: 1}, &cfbd.Drive{})
+
+	assert.ErrorIs(t, err, ErrMixedMessageTypes)
+	var n int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM game`).Scan(&n))
+	assert.Zero(t, n)
+}
+
+func TestSnakeCase_MessageNames_ShouldSplitWords(t *testing.T) {
+	for name, want := range map[string]string{
+		"Game":                   "game",
+		"TeamSP":                 "team_sp",
+		"GameTeamStats":          "game_team_stats",
+		"SpTeamOffense":          "sp_team_offense",
+		"PPAGame":                "ppa_game",
+		"TeamFPI":                "team_fpi",
+		"ClockInt32":             "clock_int32",
+		"AdvancedSeasonStatSide": "advanced_season_stat_side",
+	} {
+		assert.Equal(t, want, snakeCase(name), name)
+	}
+}
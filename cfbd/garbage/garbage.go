@@ -0,0 +1,115 @@
+// Package garbage marks plays and drives played after the result was out
+// of reach, by quarter and score margin, and filters them out of
+// iterators so that every metric leaves out the same garbage time.
+package garbage
+
+import (
+	"iter"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// Thresholds are the margins beyond which each quarter is garbage time,
+// first quarter first. A threshold of zero or less never marks the
+// quarter. Overtime is never garbage time.
+type Thresholds [4]int32
+
+// Standard are the thresholds CFBD uses: more than 38 points in the second
+// quarter, 28 in the third and 22 in the fourth.
+var Standard = Thresholds{0, 38, 28, 22}
+
+// Option configures a Classifier.
+type Option func(*Classifier)
+
+// WithThresholds sets the thresholds. The default is Standard.
+func WithThresholds(t Thresholds) Option {
+	return func(c *Classifier) {
+		c.thresholds = t
+	}
+}
+
+// WithDriveEnd classifies drives by their score and quarter at the end of
+// the drive rather than at the start.
+func WithDriveEnd() Option {
+	return func(c *Classifier) {
+		c.driveEnd = true
+	}
+}
+
+// Classifier marks garbage time.
+type Classifier struct {
+	thresholds Thresholds
+	driveEnd   bool
+}
+
+// New returns a classifier.
+func New(opts ...Option) *Classifier {
+	c := &Classifier{thresholds: Standard}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// GarbageTime reports whether a margin, of either sign, is garbage time in
+// period.
+func (c *Classifier) GarbageTime(period, margin int32) bool {
+	if period < 1 || int(period) > len(c.thresholds) {
+		return false
+	}
+	threshold := c.thresholds[period-1]
+	if margin < 0 {
+		margin = -margin
+	}
+
+	return threshold > 0 && margin > threshold
+}
+
+// Play reports whether p was played in garbage time, by its quarter and
+// the score on the play.
+func (c *Classifier) Play(p *cfbd.Play) bool {
+	return c.GarbageTime(p.GetPeriod(),
+		p.GetOffenseScore()-p.GetDefenseScore())
+}
+
+// Drive reports whether d started in garbage time, or ended in it with
+// WithDriveEnd.
+func (c *Classifier) Drive(d *cfbd.Drive) bool {
+	if c.driveEnd {
+		return c.GarbageTime(d.GetEndPeriod(),
+			d.GetEndOffenseScore()-d.GetEndDefenseScore())
+	}
+
+	return c.GarbageTime(d.GetStartPeriod(),
+		d.GetStartOffenseScore()-d.GetStartDefenseScore())
+}
+
+// Plays yields the plays of seq outside garbage time.
+func (c *Classifier) Plays(seq iter.Seq[*cfbd.Play]) iter.Seq[*cfbd.Play] {
+	return Exclude(seq, c.Play)
+}
+
+// Drives yields the drives of seq outside garbage time.
+func (c *Classifier) Drives(
+	seq iter.Seq[*cfbd.Drive],
+) iter.Seq[*cfbd.Drive] {
+	return Exclude(seq, c.Drive)
+}
+
+// Exclude yields the elements of seq that garbage reports false for, such
+// as Classifier.Play or Classifier.Drive.
+func Exclude[T any](seq iter.Seq[T], garbage func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !garbage(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Only yields the elements of seq that garbage reports true for.
+func Only[T any](seq iter.Seq[T], garbage func(T) bool) iter.Seq[T] {
+	return Exclude(seq, func(v T) bool { return !garbage(v) })
+}
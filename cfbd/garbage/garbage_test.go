@@ -0,0 +1,110 @@
+package garbage
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func play(id string, period, offense, defense int32) *cfbd.Play {
+	return &cfbd.Play{
+		Id:           id,
+		Period:       period,
+		OffenseScore: offense,
+		DefenseScore: defense,
+	}
+}
+
+func ids(plays iter.Seq[*cfbd.Play]) []string {
+	var out []string
+	for p := range plays {
+		out = append(out, p.GetId())
+	}
+
+	return out
+}
+
+func TestGarbageTime_Standard_ShouldUseQuarterThresholds(t *testing.T) {
+	c := New()
+
+	assert.False(t, c.GarbageTime(1, 60))
+	assert.False(t, c.GarbageTime(2, 38))
+	assert.True(t, c.GarbageTime(2, 39))
+	assert.True(t, c.GarbageTime(3, -29))
+	assert.False(t, c.GarbageTime(4, 22))
+	assert.True(t, c.GarbageTime(4, 23))
+	assert.False(t, c.GarbageTime(5, 60))
+	assert.False(t, c.GarbageTime(0, 60))
+}
+
+func TestGarbageTime_WithThresholds_ShouldOverride(t *testing.T) {
+	c := New(WithThresholds(Thresholds{43, 37, 27, 21}))
+
+	assert.True(t, c.GarbageTime(1, 44))
+	assert.True(t, c.GarbageTime(4, 22))
+}
+
+func TestPlay_ShouldUseOffenseAndDefenseScores(t *testing.T) {
+	c := New()
+
+	assert.True(t, c.Play(play("1", 4, 3, 31)))
+	assert.False(t, c.Play(play("2", 4, 24, 31)))
+	assert.False(t, c.Play(fixture.Load(t, "plays.json",
+		func() *cfbd.Play { return &cfbd.Play{} })[0]))
+}
+
+func TestDrive_ShouldUseStartOrEnd(t *testing.T) {
+	d := fixture.Load(t, "drives.json",
+		func() *cfbd.Drive { return &cfbd.Drive{} })[0]
+	assert.False(t, New().Drive(d))
+
+	d.StartPeriod, d.StartOffenseScore = 3, 21
+	d.EndPeriod, d.EndOffenseScore = 3, 35
+
+	assert.False(t, New().Drive(d))
+	assert.True(t, New(WithDriveEnd()).Drive(d))
+}
+
+func TestPlays_ShouldFilterIterators(t *testing.T) {
+	plays := []*cfbd.Play{
+		play("1", 2, 7, 0),
+		play("2", 3, 35, 0),
+		play("3", 4, 35, 14),
+	}
+	c := New()
+
+	kept := ids(c.Plays(slices.Values(plays)))
+	dropped := ids(Only(slices.Values(plays), c.Play))
+
+	assert.Equal(t, []string{"1", "3"}, kept)
+	assert.Equal(t, []string{"2"}, dropped)
+}
+
+func TestExclude_EarlyStop_ShouldStopSource(t *testing.T) {
+	plays := []*cfbd.Play{play("1", 1, 0, 0), play("2", 1, 0, 0)}
+
+	var got []string
+	for p := range New().Plays(slices.Values(plays)) {
+		got = append(got, p.GetId())
+		break
+	}
+
+	assert.Equal(t, []string{"1"}, got)
+}
+
+func TestDrives_ShouldFilterIterators(t *testing.T) {
+	drives := []*cfbd.Drive{
+		{Id: "1", StartPeriod: 4, StartOffenseScore: 30},
+		{Id: "2", StartPeriod: 4, StartOffenseScore: 10},
+	}
+
+	got := slices.Collect(New().Drives(slices.Values(drives)))
+
+	require.Len(t, got, 1)
+	assert.Equal(t, "2", got[0].GetId())
+}
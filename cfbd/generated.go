@@ -9488,6 +9488,294 @@ func (x *CalendarWeek) GetLastGameStart() *timestamppb.Timestamp {
 	return nil
 }
 
+type ScoreboardVenue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreboardVenue) Reset() {
+	*x = ScoreboardVenue{}
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreboardVenue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreboardVenue) ProtoMessage() {}
+
+func (x *ScoreboardVenue) ProtoReflect() protoreflect.Message {
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreboardVenue.ProtoReflect.Descriptor instead.
+func (*ScoreboardVenue) Descriptor() ([]byte, []int) {
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ScoreboardVenue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScoreboardVenue) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ScoreboardVenue) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type ScoreboardTeam struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Conference     string                 `protobuf:"bytes,3,opt,name=conference,proto3" json:"conference,omitempty"`
+	Classification string                 `protobuf:"bytes,4,opt,name=classification,proto3" json:"classification,omitempty"`
+	Points         *int32                 `protobuf:"varint,5,opt,name=points,proto3,oneof" json:"points,omitempty"`
+	LineScores     []int32                `protobuf:"varint,6,rep,packed,name=line_scores,json=lineScores,proto3" json:"line_scores,omitempty"`
+	WinProbability *float64               `protobuf:"fixed64,7,opt,name=win_probability,json=winProbability,proto3,oneof" json:"win_probability,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScoreboardTeam) Reset() {
+	*x = ScoreboardTeam{}
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreboardTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreboardTeam) ProtoMessage() {}
+
+func (x *ScoreboardTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreboardTeam.ProtoReflect.Descriptor instead.
+func (*ScoreboardTeam) Descriptor() ([]byte, []int) {
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ScoreboardTeam) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScoreboardTeam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScoreboardTeam) GetConference() string {
+	if x != nil {
+		return x.Conference
+	}
+	return ""
+}
+
+func (x *ScoreboardTeam) GetClassification() string {
+	if x != nil {
+		return x.Classification
+	}
+	return ""
+}
+
+func (x *ScoreboardTeam) GetPoints() int32 {
+	if x != nil && x.Points != nil {
+		return *x.Points
+	}
+	return 0
+}
+
+func (x *ScoreboardTeam) GetLineScores() []int32 {
+	if x != nil {
+		return x.LineScores
+	}
+	return nil
+}
+
+func (x *ScoreboardTeam) GetWinProbability() float64 {
+	if x != nil && x.WinProbability != nil {
+		return *x.WinProbability
+	}
+	return 0
+}
+
+type ScoreboardWeather struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Temperature   *float64               `protobuf:"fixed64,1,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	WindSpeed     *float64               `protobuf:"fixed64,3,opt,name=wind_speed,json=windSpeed,proto3,oneof" json:"wind_speed,omitempty"`
+	WindDirection *float64               `protobuf:"fixed64,4,opt,name=wind_direction,json=windDirection,proto3,oneof" json:"wind_direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreboardWeather) Reset() {
+	*x = ScoreboardWeather{}
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreboardWeather) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreboardWeather) ProtoMessage() {}
+
+func (x *ScoreboardWeather) ProtoReflect() protoreflect.Message {
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreboardWeather.ProtoReflect.Descriptor instead.
+func (*ScoreboardWeather) Descriptor() ([]byte, []int) {
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ScoreboardWeather) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *ScoreboardWeather) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScoreboardWeather) GetWindSpeed() float64 {
+	if x != nil && x.WindSpeed != nil {
+		return *x.WindSpeed
+	}
+	return 0
+}
+
+func (x *ScoreboardWeather) GetWindDirection() float64 {
+	if x != nil && x.WindDirection != nil {
+		return *x.WindDirection
+	}
+	return 0
+}
+
+type ScoreboardBetting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spread        *float64               `protobuf:"fixed64,1,opt,name=spread,proto3,oneof" json:"spread,omitempty"`
+	OverUnder     *float64               `protobuf:"fixed64,2,opt,name=over_under,json=overUnder,proto3,oneof" json:"over_under,omitempty"`
+	HomeMoneyline *float64               `protobuf:"fixed64,3,opt,name=home_moneyline,json=homeMoneyline,proto3,oneof" json:"home_moneyline,omitempty"`
+	AwayMoneyline *float64               `protobuf:"fixed64,4,opt,name=away_moneyline,json=awayMoneyline,proto3,oneof" json:"away_moneyline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreboardBetting) Reset() {
+	*x = ScoreboardBetting{}
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreboardBetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreboardBetting) ProtoMessage() {}
+
+func (x *ScoreboardBetting) ProtoReflect() protoreflect.Message {
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreboardBetting.ProtoReflect.Descriptor instead.
+func (*ScoreboardBetting) Descriptor() ([]byte, []int) {
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ScoreboardBetting) GetSpread() float64 {
+	if x != nil && x.Spread != nil {
+		return *x.Spread
+	}
+	return 0
+}
+
+func (x *ScoreboardBetting) GetOverUnder() float64 {
+	if x != nil && x.OverUnder != nil {
+		return *x.OverUnder
+	}
+	return 0
+}
+
+func (x *ScoreboardBetting) GetHomeMoneyline() float64 {
+	if x != nil && x.HomeMoneyline != nil {
+		return *x.HomeMoneyline
+	}
+	return 0
+}
+
+func (x *ScoreboardBetting) GetAwayMoneyline() float64 {
+	if x != nil && x.AwayMoneyline != nil {
+		return *x.AwayMoneyline
+	}
+	return 0
+}
+
 type Scoreboard struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -9502,18 +9790,18 @@ type Scoreboard struct {
 	Situation      string                 `protobuf:"bytes,10,opt,name=situation,proto3" json:"situation,omitempty"`
 	Possession     string                 `protobuf:"bytes,11,opt,name=possession,proto3" json:"possession,omitempty"`
 	LastPlay       string                 `protobuf:"bytes,12,opt,name=last_play,json=lastPlay,proto3" json:"last_play,omitempty"`
-	Venue          *structpb.Struct       `protobuf:"bytes,13,opt,name=venue,proto3" json:"venue,omitempty"`
-	HomeTeam       *structpb.Struct       `protobuf:"bytes,14,opt,name=home_team,json=homeTeam,proto3" json:"home_team,omitempty"`
-	AwayTeam       *structpb.Struct       `protobuf:"bytes,15,opt,name=away_team,json=awayTeam,proto3" json:"away_team,omitempty"`
-	Weather        *structpb.Struct       `protobuf:"bytes,16,opt,name=weather,proto3" json:"weather,omitempty"`
-	Betting        *structpb.Struct       `protobuf:"bytes,17,opt,name=betting,proto3" json:"betting,omitempty"`
+	Venue          *ScoreboardVenue       `protobuf:"bytes,13,opt,name=venue,proto3" json:"venue,omitempty"`
+	HomeTeam       *ScoreboardTeam        `protobuf:"bytes,14,opt,name=home_team,json=homeTeam,proto3" json:"home_team,omitempty"`
+	AwayTeam       *ScoreboardTeam        `protobuf:"bytes,15,opt,name=away_team,json=awayTeam,proto3" json:"away_team,omitempty"`
+	Weather        *ScoreboardWeather     `protobuf:"bytes,16,opt,name=weather,proto3" json:"weather,omitempty"`
+	Betting        *ScoreboardBetting     `protobuf:"bytes,17,opt,name=betting,proto3" json:"betting,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Scoreboard) Reset() {
 	*x = Scoreboard{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[94]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9525,7 +9813,7 @@ func (x *Scoreboard) String() string {
 func (*Scoreboard) ProtoMessage() {}
 
 func (x *Scoreboard) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[94]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9538,7 +9826,7 @@ func (x *Scoreboard) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Scoreboard.ProtoReflect.Descriptor instead.
 func (*Scoreboard) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{94}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *Scoreboard) GetId() int32 {
@@ -9625,35 +9913,35 @@ func (x *Scoreboard) GetLastPlay() string {
 	return ""
 }
 
-func (x *Scoreboard) GetVenue() *structpb.Struct {
+func (x *Scoreboard) GetVenue() *ScoreboardVenue {
 	if x != nil {
 		return x.Venue
 	}
 	return nil
 }
 
-func (x *Scoreboard) GetHomeTeam() *structpb.Struct {
+func (x *Scoreboard) GetHomeTeam() *ScoreboardTeam {
 	if x != nil {
 		return x.HomeTeam
 	}
 	return nil
 }
 
-func (x *Scoreboard) GetAwayTeam() *structpb.Struct {
+func (x *Scoreboard) GetAwayTeam() *ScoreboardTeam {
 	if x != nil {
 		return x.AwayTeam
 	}
 	return nil
 }
 
-func (x *Scoreboard) GetWeather() *structpb.Struct {
+func (x *Scoreboard) GetWeather() *ScoreboardWeather {
 	if x != nil {
 		return x.Weather
 	}
 	return nil
 }
 
-func (x *Scoreboard) GetBetting() *structpb.Struct {
+func (x *Scoreboard) GetBetting() *ScoreboardBetting {
 	if x != nil {
 		return x.Betting
 	}
@@ -9693,7 +9981,7 @@ type Drive struct {
 
 func (x *Drive) Reset() {
 	*x = Drive{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[95]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9705,7 +9993,7 @@ func (x *Drive) String() string {
 func (*Drive) ProtoMessage() {}
 
 func (x *Drive) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[95]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9718,7 +10006,7 @@ func (x *Drive) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Drive.ProtoReflect.Descriptor instead.
 func (*Drive) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{95}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *Drive) GetOffense() string {
@@ -9908,7 +10196,7 @@ type DraftTeam struct {
 
 func (x *DraftTeam) Reset() {
 	*x = DraftTeam{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[96]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9920,7 +10208,7 @@ func (x *DraftTeam) String() string {
 func (*DraftTeam) ProtoMessage() {}
 
 func (x *DraftTeam) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[96]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9933,7 +10221,7 @@ func (x *DraftTeam) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DraftTeam.ProtoReflect.Descriptor instead.
 func (*DraftTeam) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{96}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *DraftTeam) GetLocation() string {
@@ -9974,7 +10262,7 @@ type DraftPosition struct {
 
 func (x *DraftPosition) Reset() {
 	*x = DraftPosition{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[97]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9986,7 +10274,7 @@ func (x *DraftPosition) String() string {
 func (*DraftPosition) ProtoMessage() {}
 
 func (x *DraftPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[97]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9999,7 +10287,7 @@ func (x *DraftPosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DraftPosition.ProtoReflect.Descriptor instead.
 func (*DraftPosition) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{97}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *DraftPosition) GetName() string {
@@ -10030,7 +10318,7 @@ type DraftPickHometownInfo struct {
 
 func (x *DraftPickHometownInfo) Reset() {
 	*x = DraftPickHometownInfo{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[98]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10042,7 +10330,7 @@ func (x *DraftPickHometownInfo) String() string {
 func (*DraftPickHometownInfo) ProtoMessage() {}
 
 func (x *DraftPickHometownInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[98]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10055,7 +10343,7 @@ func (x *DraftPickHometownInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DraftPickHometownInfo.ProtoReflect.Descriptor instead.
 func (*DraftPickHometownInfo) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{98}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *DraftPickHometownInfo) GetCountyFips() string {
@@ -10127,7 +10415,7 @@ type DraftPick struct {
 
 func (x *DraftPick) Reset() {
 	*x = DraftPick{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[99]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10139,7 +10427,7 @@ func (x *DraftPick) String() string {
 func (*DraftPick) ProtoMessage() {}
 
 func (x *DraftPick) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[99]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10152,7 +10440,7 @@ func (x *DraftPick) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DraftPick.ProtoReflect.Descriptor instead.
 func (*DraftPick) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{99}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *DraftPick) GetCollegeAthleteId() int32 {
@@ -10308,7 +10596,7 @@ type CoachSeason struct {
 
 func (x *CoachSeason) Reset() {
 	*x = CoachSeason{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[100]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10320,7 +10608,7 @@ func (x *CoachSeason) String() string {
 func (*CoachSeason) ProtoMessage() {}
 
 func (x *CoachSeason) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[100]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10333,7 +10621,7 @@ func (x *CoachSeason) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CoachSeason.ProtoReflect.Descriptor instead.
 func (*CoachSeason) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{100}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *CoachSeason) GetSchool() string {
@@ -10432,7 +10720,7 @@ type Coach struct {
 
 func (x *Coach) Reset() {
 	*x = Coach{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[101]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10444,7 +10732,7 @@ func (x *Coach) String() string {
 func (*Coach) ProtoMessage() {}
 
 func (x *Coach) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[101]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10457,7 +10745,7 @@ func (x *Coach) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Coach.ProtoReflect.Descriptor instead.
 func (*Coach) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{101}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *Coach) GetFirstName() string {
@@ -10501,7 +10789,7 @@ type StatsByQuarter struct {
 
 func (x *StatsByQuarter) Reset() {
 	*x = StatsByQuarter{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[102]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10513,7 +10801,7 @@ func (x *StatsByQuarter) String() string {
 func (*StatsByQuarter) ProtoMessage() {}
 
 func (x *StatsByQuarter) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[102]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10526,7 +10814,7 @@ func (x *StatsByQuarter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsByQuarter.ProtoReflect.Descriptor instead.
 func (*StatsByQuarter) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{102}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *StatsByQuarter) GetTotal() float64 {
@@ -10577,7 +10865,7 @@ type TeamPPA struct {
 
 func (x *TeamPPA) Reset() {
 	*x = TeamPPA{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[103]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10589,7 +10877,7 @@ func (x *TeamPPA) String() string {
 func (*TeamPPA) ProtoMessage() {}
 
 func (x *TeamPPA) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[103]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10602,7 +10890,7 @@ func (x *TeamPPA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamPPA.ProtoReflect.Descriptor instead.
 func (*TeamPPA) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{103}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *TeamPPA) GetTeam() string {
@@ -10652,7 +10940,7 @@ type TeamSuccessRates struct {
 
 func (x *TeamSuccessRates) Reset() {
 	*x = TeamSuccessRates{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[104]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10664,7 +10952,7 @@ func (x *TeamSuccessRates) String() string {
 func (*TeamSuccessRates) ProtoMessage() {}
 
 func (x *TeamSuccessRates) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[104]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10677,7 +10965,7 @@ func (x *TeamSuccessRates) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamSuccessRates.ProtoReflect.Descriptor instead.
 func (*TeamSuccessRates) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{104}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *TeamSuccessRates) GetTeam() string {
@@ -10718,7 +11006,7 @@ type TeamExplosiveness struct {
 
 func (x *TeamExplosiveness) Reset() {
 	*x = TeamExplosiveness{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[105]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10730,7 +11018,7 @@ func (x *TeamExplosiveness) String() string {
 func (*TeamExplosiveness) ProtoMessage() {}
 
 func (x *TeamExplosiveness) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[105]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10743,7 +11031,7 @@ func (x *TeamExplosiveness) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamExplosiveness.ProtoReflect.Descriptor instead.
 func (*TeamExplosiveness) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{105}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *TeamExplosiveness) GetTeam() string {
@@ -10777,7 +11065,7 @@ type TeamRushingStats struct {
 
 func (x *TeamRushingStats) Reset() {
 	*x = TeamRushingStats{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[106]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10789,7 +11077,7 @@ func (x *TeamRushingStats) String() string {
 func (*TeamRushingStats) ProtoMessage() {}
 
 func (x *TeamRushingStats) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[106]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10802,7 +11090,7 @@ func (x *TeamRushingStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamRushingStats.ProtoReflect.Descriptor instead.
 func (*TeamRushingStats) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{106}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *TeamRushingStats) GetTeam() string {
@@ -10880,7 +11168,7 @@ type TeamHavoc struct {
 
 func (x *TeamHavoc) Reset() {
 	*x = TeamHavoc{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[107]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10892,7 +11180,7 @@ func (x *TeamHavoc) String() string {
 func (*TeamHavoc) ProtoMessage() {}
 
 func (x *TeamHavoc) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[107]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10905,7 +11193,7 @@ func (x *TeamHavoc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamHavoc.ProtoReflect.Descriptor instead.
 func (*TeamHavoc) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{107}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *TeamHavoc) GetTeam() string {
@@ -10948,7 +11236,7 @@ type TeamScoringOpportunities struct {
 
 func (x *TeamScoringOpportunities) Reset() {
 	*x = TeamScoringOpportunities{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[108]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10960,7 +11248,7 @@ func (x *TeamScoringOpportunities) String() string {
 func (*TeamScoringOpportunities) ProtoMessage() {}
 
 func (x *TeamScoringOpportunities) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[108]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10973,7 +11261,7 @@ func (x *TeamScoringOpportunities) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamScoringOpportunities.ProtoReflect.Descriptor instead.
 func (*TeamScoringOpportunities) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{108}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *TeamScoringOpportunities) GetTeam() string {
@@ -11015,7 +11303,7 @@ type TeamFieldPosition struct {
 
 func (x *TeamFieldPosition) Reset() {
 	*x = TeamFieldPosition{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[109]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11027,7 +11315,7 @@ func (x *TeamFieldPosition) String() string {
 func (*TeamFieldPosition) ProtoMessage() {}
 
 func (x *TeamFieldPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[109]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11040,7 +11328,7 @@ func (x *TeamFieldPosition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamFieldPosition.ProtoReflect.Descriptor instead.
 func (*TeamFieldPosition) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{109}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *TeamFieldPosition) GetTeam() string {
@@ -11082,7 +11370,7 @@ type PlayerGameUsage struct {
 
 func (x *PlayerGameUsage) Reset() {
 	*x = PlayerGameUsage{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[110]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11094,7 +11382,7 @@ func (x *PlayerGameUsage) String() string {
 func (*PlayerGameUsage) ProtoMessage() {}
 
 func (x *PlayerGameUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[110]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11107,7 +11395,7 @@ func (x *PlayerGameUsage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerGameUsage.ProtoReflect.Descriptor instead.
 func (*PlayerGameUsage) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{110}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *PlayerGameUsage) GetTotal() float64 {
@@ -11195,7 +11483,7 @@ type PlayerStatsByQuarter struct {
 
 func (x *PlayerStatsByQuarter) Reset() {
 	*x = PlayerStatsByQuarter{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[111]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11207,7 +11495,7 @@ func (x *PlayerStatsByQuarter) String() string {
 func (*PlayerStatsByQuarter) ProtoMessage() {}
 
 func (x *PlayerStatsByQuarter) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[111]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11220,7 +11508,7 @@ func (x *PlayerStatsByQuarter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerStatsByQuarter.ProtoReflect.Descriptor instead.
 func (*PlayerStatsByQuarter) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{111}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *PlayerStatsByQuarter) GetTotal() float64 {
@@ -11285,7 +11573,7 @@ type PlayerPPA struct {
 
 func (x *PlayerPPA) Reset() {
 	*x = PlayerPPA{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[112]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11297,7 +11585,7 @@ func (x *PlayerPPA) String() string {
 func (*PlayerPPA) ProtoMessage() {}
 
 func (x *PlayerPPA) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[112]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11310,7 +11598,7 @@ func (x *PlayerPPA) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerPPA.ProtoReflect.Descriptor instead.
 func (*PlayerPPA) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{112}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *PlayerPPA) GetPlayer() string {
@@ -11364,7 +11652,7 @@ type AdvancedBoxScoreGameInfo struct {
 
 func (x *AdvancedBoxScoreGameInfo) Reset() {
 	*x = AdvancedBoxScoreGameInfo{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[113]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11376,7 +11664,7 @@ func (x *AdvancedBoxScoreGameInfo) String() string {
 func (*AdvancedBoxScoreGameInfo) ProtoMessage() {}
 
 func (x *AdvancedBoxScoreGameInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[113]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11389,7 +11677,7 @@ func (x *AdvancedBoxScoreGameInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdvancedBoxScoreGameInfo.ProtoReflect.Descriptor instead.
 func (*AdvancedBoxScoreGameInfo) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{113}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *AdvancedBoxScoreGameInfo) GetExcitement() float64 {
@@ -11464,7 +11752,7 @@ type AdvancedBoxScoreTeams struct {
 
 func (x *AdvancedBoxScoreTeams) Reset() {
 	*x = AdvancedBoxScoreTeams{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[114]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11476,7 +11764,7 @@ func (x *AdvancedBoxScoreTeams) String() string {
 func (*AdvancedBoxScoreTeams) ProtoMessage() {}
 
 func (x *AdvancedBoxScoreTeams) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[114]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11489,7 +11777,7 @@ func (x *AdvancedBoxScoreTeams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdvancedBoxScoreTeams.ProtoReflect.Descriptor instead.
 func (*AdvancedBoxScoreTeams) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{114}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *AdvancedBoxScoreTeams) GetFieldPosition() []*TeamFieldPosition {
@@ -11558,7 +11846,7 @@ type AdvancedBoxScorePlayers struct {
 
 func (x *AdvancedBoxScorePlayers) Reset() {
 	*x = AdvancedBoxScorePlayers{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[115]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11570,7 +11858,7 @@ func (x *AdvancedBoxScorePlayers) String() string {
 func (*AdvancedBoxScorePlayers) ProtoMessage() {}
 
 func (x *AdvancedBoxScorePlayers) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[115]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11583,7 +11871,7 @@ func (x *AdvancedBoxScorePlayers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdvancedBoxScorePlayers.ProtoReflect.Descriptor instead.
 func (*AdvancedBoxScorePlayers) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{115}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *AdvancedBoxScorePlayers) GetPpa() []*PlayerPPA {
@@ -11611,7 +11899,7 @@ type AdvancedBoxScore struct {
 
 func (x *AdvancedBoxScore) Reset() {
 	*x = AdvancedBoxScore{}
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[116]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11623,7 +11911,7 @@ func (x *AdvancedBoxScore) String() string {
 func (*AdvancedBoxScore) ProtoMessage() {}
 
 func (x *AdvancedBoxScore) ProtoReflect() protoreflect.Message {
-	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[116]
+	mi := &file_cfbd_internal_proto_cfbd_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11636,7 +11924,7 @@ func (x *AdvancedBoxScore) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdvancedBoxScore.ProtoReflect.Descriptor instead.
 func (*AdvancedBoxScore) Descriptor() ([]byte, []int) {
-	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{116}
+	return file_cfbd_internal_proto_cfbd_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *AdvancedBoxScore) GetGameInfo() *AdvancedBoxScoreGameInfo {
@@ -12959,7 +13247,43 @@ const file_cfbd_internal_proto_cfbd_proto_rawDesc = "" +
 	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
 	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12H\n" +
 	"\x10first_game_start\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x02\x18\x01R\x0efirstGameStart\x12F\n" +
-	"\x0flast_game_start\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x02\x18\x01R\rlastGameStart\"\x8b\x05\n" +
+	"\x0flast_game_start\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x02\x18\x01R\rlastGameStart\"O\n" +
+	"\x0fScoreboardVenue\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\"\x87\x02\n" +
+	"\x0eScoreboardTeam\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"conference\x18\x03 \x01(\tR\n" +
+	"conference\x12&\n" +
+	"\x0eclassification\x18\x04 \x01(\tR\x0eclassification\x12\x1b\n" +
+	"\x06points\x18\x05 \x01(\x05H\x00R\x06points\x88\x01\x01\x12\x1f\n" +
+	"\vline_scores\x18\x06 \x03(\x05R\n" +
+	"lineScores\x12,\n" +
+	"\x0fwin_probability\x18\a \x01(\x01H\x01R\x0ewinProbability\x88\x01\x01B\t\n" +
+	"\a_pointsB\x12\n" +
+	"\x10_win_probability\"\xde\x01\n" +
+	"\x11ScoreboardWeather\x12%\n" +
+	"\vtemperature\x18\x01 \x01(\x01H\x00R\vtemperature\x88\x01\x01\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\"\n" +
+	"\n" +
+	"wind_speed\x18\x03 \x01(\x01H\x01R\twindSpeed\x88\x01\x01\x12*\n" +
+	"\x0ewind_direction\x18\x04 \x01(\x01H\x02R\rwindDirection\x88\x01\x01B\x0e\n" +
+	"\f_temperatureB\r\n" +
+	"\v_wind_speedB\x11\n" +
+	"\x0f_wind_direction\"\xec\x01\n" +
+	"\x11ScoreboardBetting\x12\x1b\n" +
+	"\x06spread\x18\x01 \x01(\x01H\x00R\x06spread\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"over_under\x18\x02 \x01(\x01H\x01R\toverUnder\x88\x01\x01\x12*\n" +
+	"\x0ehome_moneyline\x18\x03 \x01(\x01H\x02R\rhomeMoneyline\x88\x01\x01\x12*\n" +
+	"\x0eaway_moneyline\x18\x04 \x01(\x01H\x03R\rawayMoneyline\x88\x01\x01B\t\n" +
+	"\a_spreadB\r\n" +
+	"\v_over_underB\x11\n" +
+	"\x0f_home_moneylineB\x11\n" +
+	"\x0f_away_moneyline\"\x92\x05\n" +
 	"\n" +
 	"Scoreboard\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x129\n" +
@@ -12977,12 +13301,12 @@ const file_cfbd_internal_proto_cfbd_proto_rawDesc = "" +
 	"\n" +
 	"possession\x18\v \x01(\tR\n" +
 	"possession\x12\x1b\n" +
-	"\tlast_play\x18\f \x01(\tR\blastPlay\x12-\n" +
-	"\x05venue\x18\r \x01(\v2\x17.google.protobuf.StructR\x05venue\x124\n" +
-	"\thome_team\x18\x0e \x01(\v2\x17.google.protobuf.StructR\bhomeTeam\x124\n" +
-	"\taway_team\x18\x0f \x01(\v2\x17.google.protobuf.StructR\bawayTeam\x121\n" +
-	"\aweather\x18\x10 \x01(\v2\x17.google.protobuf.StructR\aweather\x121\n" +
-	"\abetting\x18\x11 \x01(\v2\x17.google.protobuf.StructR\abettingB\t\n" +
+	"\tlast_play\x18\f \x01(\tR\blastPlay\x12.\n" +
+	"\x05venue\x18\r \x01(\v2\x18.cfbd.v1.ScoreboardVenueR\x05venue\x124\n" +
+	"\thome_team\x18\x0e \x01(\v2\x17.cfbd.v1.ScoreboardTeamR\bhomeTeam\x124\n" +
+	"\taway_team\x18\x0f \x01(\v2\x17.cfbd.v1.ScoreboardTeamR\bawayTeam\x124\n" +
+	"\aweather\x18\x10 \x01(\v2\x1a.cfbd.v1.ScoreboardWeatherR\aweather\x124\n" +
+	"\abetting\x18\x11 \x01(\v2\x1a.cfbd.v1.ScoreboardBettingR\abettingB\t\n" +
 	"\a_period\"\xbd\a\n" +
 	"\x05Drive\x12\x18\n" +
 	"\aoffense\x18\x01 \x01(\tR\aoffense\x12-\n" +
@@ -13219,7 +13543,7 @@ func file_cfbd_internal_proto_cfbd_proto_rawDescGZIP() []byte {
 	return file_cfbd_internal_proto_cfbd_proto_rawDescData
 }
 
-var file_cfbd_internal_proto_cfbd_proto_msgTypes = make([]protoimpl.MessageInfo, 117)
+var file_cfbd_internal_proto_cfbd_proto_msgTypes = make([]protoimpl.MessageInfo, 121)
 var file_cfbd_internal_proto_cfbd_proto_goTypes = []any{
 	(*EpaSplit)(nil),                           // 0: cfbd.v1.EpaSplit
 	(*SuccessRateSplit)(nil),                   // 1: cfbd.v1.SuccessRateSplit
@@ -13315,35 +13639,38 @@ var file_cfbd_internal_proto_cfbd_proto_goTypes = []any{
 	(*TeamRecord)(nil),                         // 91: cfbd.v1.TeamRecord
 	(*TeamRecords)(nil),                        // 92: cfbd.v1.TeamRecords
 	(*CalendarWeek)(nil),                       // 93: cfbd.v1.CalendarWeek
-	(*Scoreboard)(nil),                         // 94: cfbd.v1.Scoreboard
-	(*Drive)(nil),                              // 95: cfbd.v1.Drive
-	(*DraftTeam)(nil),                          // 96: cfbd.v1.DraftTeam
-	(*DraftPosition)(nil),                      // 97: cfbd.v1.DraftPosition
-	(*DraftPickHometownInfo)(nil),              // 98: cfbd.v1.DraftPickHometownInfo
-	(*DraftPick)(nil),                          // 99: cfbd.v1.DraftPick
-	(*CoachSeason)(nil),                        // 100: cfbd.v1.CoachSeason
-	(*Coach)(nil),                              // 101: cfbd.v1.Coach
-	(*StatsByQuarter)(nil),                     // 102: cfbd.v1.StatsByQuarter
-	(*TeamPPA)(nil),                            // 103: cfbd.v1.TeamPPA
-	(*TeamSuccessRates)(nil),                   // 104: cfbd.v1.TeamSuccessRates
-	(*TeamExplosiveness)(nil),                  // 105: cfbd.v1.TeamExplosiveness
-	(*TeamRushingStats)(nil),                   // 106: cfbd.v1.TeamRushingStats
-	(*TeamHavoc)(nil),                          // 107: cfbd.v1.TeamHavoc
-	(*TeamScoringOpportunities)(nil),           // 108: cfbd.v1.TeamScoringOpportunities
-	(*TeamFieldPosition)(nil),                  // 109: cfbd.v1.TeamFieldPosition
-	(*PlayerGameUsage)(nil),                    // 110: cfbd.v1.PlayerGameUsage
-	(*PlayerStatsByQuarter)(nil),               // 111: cfbd.v1.PlayerStatsByQuarter
-	(*PlayerPPA)(nil),                          // 112: cfbd.v1.PlayerPPA
-	(*AdvancedBoxScoreGameInfo)(nil),           // 113: cfbd.v1.AdvancedBoxScoreGameInfo
-	(*AdvancedBoxScoreTeams)(nil),              // 114: cfbd.v1.AdvancedBoxScoreTeams
-	(*AdvancedBoxScorePlayers)(nil),            // 115: cfbd.v1.AdvancedBoxScorePlayers
-	(*AdvancedBoxScore)(nil),                   // 116: cfbd.v1.AdvancedBoxScore
-	(*structpb.Value)(nil),                     // 117: google.protobuf.Value
-	(*timestamppb.Timestamp)(nil),              // 118: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),                    // 119: google.protobuf.Struct
+	(*ScoreboardVenue)(nil),                    // 94: cfbd.v1.ScoreboardVenue
+	(*ScoreboardTeam)(nil),                     // 95: cfbd.v1.ScoreboardTeam
+	(*ScoreboardWeather)(nil),                  // 96: cfbd.v1.ScoreboardWeather
+	(*ScoreboardBetting)(nil),                  // 97: cfbd.v1.ScoreboardBetting
+	(*Scoreboard)(nil),                         // 98: cfbd.v1.Scoreboard
+	(*Drive)(nil),                              // 99: cfbd.v1.Drive
+	(*DraftTeam)(nil),                          // 100: cfbd.v1.DraftTeam
+	(*DraftPosition)(nil),                      // 101: cfbd.v1.DraftPosition
+	(*DraftPickHometownInfo)(nil),              // 102: cfbd.v1.DraftPickHometownInfo
+	(*DraftPick)(nil),                          // 103: cfbd.v1.DraftPick
+	(*CoachSeason)(nil),                        // 104: cfbd.v1.CoachSeason
+	(*Coach)(nil),                              // 105: cfbd.v1.Coach
+	(*StatsByQuarter)(nil),                     // 106: cfbd.v1.StatsByQuarter
+	(*TeamPPA)(nil),                            // 107: cfbd.v1.TeamPPA
+	(*TeamSuccessRates)(nil),                   // 108: cfbd.v1.TeamSuccessRates
+	(*TeamExplosiveness)(nil),                  // 109: cfbd.v1.TeamExplosiveness
+	(*TeamRushingStats)(nil),                   // 110: cfbd.v1.TeamRushingStats
+	(*TeamHavoc)(nil),                          // 111: cfbd.v1.TeamHavoc
+	(*TeamScoringOpportunities)(nil),           // 112: cfbd.v1.TeamScoringOpportunities
+	(*TeamFieldPosition)(nil),                  // 113: cfbd.v1.TeamFieldPosition
+	(*PlayerGameUsage)(nil),                    // 114: cfbd.v1.PlayerGameUsage
+	(*PlayerStatsByQuarter)(nil),               // 115: cfbd.v1.PlayerStatsByQuarter
+	(*PlayerPPA)(nil),                          // 116: cfbd.v1.PlayerPPA
+	(*AdvancedBoxScoreGameInfo)(nil),           // 117: cfbd.v1.AdvancedBoxScoreGameInfo
+	(*AdvancedBoxScoreTeams)(nil),              // 118: cfbd.v1.AdvancedBoxScoreTeams
+	(*AdvancedBoxScorePlayers)(nil),            // 119: cfbd.v1.AdvancedBoxScorePlayers
+	(*AdvancedBoxScore)(nil),                   // 120: cfbd.v1.AdvancedBoxScore
+	(*structpb.Value)(nil),                     // 121: google.protobuf.Value
+	(*timestamppb.Timestamp)(nil),              // 122: google.protobuf.Timestamp
 }
 var file_cfbd_internal_proto_cfbd_proto_depIdxs = []int32{
-	117, // 0: cfbd.v1.StatValue.value:type_name -> google.protobuf.Value
+	121, // 0: cfbd.v1.StatValue.value:type_name -> google.protobuf.Value
 	0,   // 1: cfbd.v1.AdjustedTeamMetrics.epa:type_name -> cfbd.v1.EpaSplit
 	0,   // 2: cfbd.v1.AdjustedTeamMetrics.epa_allowed:type_name -> cfbd.v1.EpaSplit
 	1,   // 3: cfbd.v1.AdjustedTeamMetrics.success_rate:type_name -> cfbd.v1.SuccessRateSplit
@@ -13352,7 +13679,7 @@ var file_cfbd_internal_proto_cfbd_proto_depIdxs = []int32{
 	2,   // 6: cfbd.v1.AdjustedTeamMetrics.rushing_allowed:type_name -> cfbd.v1.RushingYardsSplit
 	9,   // 7: cfbd.v1.Team.location:type_name -> cfbd.v1.Venue
 	11,  // 8: cfbd.v1.Matchup.games:type_name -> cfbd.v1.MatchupGame
-	117, // 9: cfbd.v1.TeamStat.stat_value:type_name -> google.protobuf.Value
+	121, // 9: cfbd.v1.TeamStat.stat_value:type_name -> google.protobuf.Value
 	19,  // 10: cfbd.v1.AdvancedSeasonStatSide.passing_plays:type_name -> cfbd.v1.AdvancedRateMetrics
 	19,  // 11: cfbd.v1.AdvancedSeasonStatSide.rushing_plays:type_name -> cfbd.v1.AdvancedRateMetrics
 	19,  // 12: cfbd.v1.AdvancedSeasonStatSide.passing_downs:type_name -> cfbd.v1.AdvancedRateMetrics
@@ -13385,7 +13712,7 @@ var file_cfbd_internal_proto_cfbd_proto_depIdxs = []int32{
 	3,   // 39: cfbd.v1.Play.clock:type_name -> cfbd.v1.ClockInt32
 	4,   // 40: cfbd.v1.PlayStat.clock:type_name -> cfbd.v1.ClockDouble
 	55,  // 41: cfbd.v1.PlayerUsage.usage:type_name -> cfbd.v1.PlayerUsageSplits
-	118, // 42: cfbd.v1.PlayerTransfer.transfer_date:type_name -> google.protobuf.Timestamp
+	122, // 42: cfbd.v1.PlayerTransfer.transfer_date:type_name -> google.protobuf.Timestamp
 	60,  // 43: cfbd.v1.TeamSeasonPredictedPointsAddedUnit.cumulative:type_name -> cfbd.v1.PredictedPointsAddedTotals
 	62,  // 44: cfbd.v1.TeamSeasonPredictedPointsAdded.offense:type_name -> cfbd.v1.TeamSeasonPredictedPointsAddedUnit
 	62,  // 45: cfbd.v1.TeamSeasonPredictedPointsAdded.defense:type_name -> cfbd.v1.TeamSeasonPredictedPointsAddedUnit
@@ -13394,21 +13721,21 @@ var file_cfbd_internal_proto_cfbd_proto_depIdxs = []int32{
 	65,  // 48: cfbd.v1.PlayerGamePredictedPointsAdded.average_PPA:type_name -> cfbd.v1.AveragePpa
 	67,  // 49: cfbd.v1.PlayerSeasonPredictedPointsAdded.average_PPA:type_name -> cfbd.v1.PlayerSeasonPpaSplits
 	67,  // 50: cfbd.v1.PlayerSeasonPredictedPointsAdded.total_PPA:type_name -> cfbd.v1.PlayerSeasonPpaSplits
-	118, // 51: cfbd.v1.LiveGamePlay.wall_clock:type_name -> google.protobuf.Timestamp
+	122, // 51: cfbd.v1.LiveGamePlay.wall_clock:type_name -> google.protobuf.Timestamp
 	73,  // 52: cfbd.v1.LiveGameDrive.plays:type_name -> cfbd.v1.LiveGamePlay
 	72,  // 53: cfbd.v1.LiveGame.teams:type_name -> cfbd.v1.LiveGameTeam
 	74,  // 54: cfbd.v1.LiveGame.drives:type_name -> cfbd.v1.LiveGameDrive
-	118, // 55: cfbd.v1.BettingGame.start_date:type_name -> google.protobuf.Timestamp
+	122, // 55: cfbd.v1.BettingGame.start_date:type_name -> google.protobuf.Timestamp
 	76,  // 56: cfbd.v1.BettingGame.lines:type_name -> cfbd.v1.GameLine
-	118, // 57: cfbd.v1.Game.start_date:type_name -> google.protobuf.Timestamp
+	122, // 57: cfbd.v1.Game.start_date:type_name -> google.protobuf.Timestamp
 	81,  // 58: cfbd.v1.GameTeamStatsTeam.stats:type_name -> cfbd.v1.GameTeamStatsTeamStat
 	82,  // 59: cfbd.v1.GameTeamStats.teams:type_name -> cfbd.v1.GameTeamStatsTeam
 	84,  // 60: cfbd.v1.GamePlayerStatTypes.athletes:type_name -> cfbd.v1.GamePlayerStatPlayer
 	85,  // 61: cfbd.v1.GamePlayerStatCategories.types:type_name -> cfbd.v1.GamePlayerStatTypes
 	86,  // 62: cfbd.v1.GamePlayerStatsTeam.categories:type_name -> cfbd.v1.GamePlayerStatCategories
 	87,  // 63: cfbd.v1.GamePlayerStats.teams:type_name -> cfbd.v1.GamePlayerStatsTeam
-	118, // 64: cfbd.v1.GameMedia.start_time:type_name -> google.protobuf.Timestamp
-	118, // 65: cfbd.v1.GameWeather.start_time:type_name -> google.protobuf.Timestamp
+	122, // 64: cfbd.v1.GameMedia.start_time:type_name -> google.protobuf.Timestamp
+	122, // 65: cfbd.v1.GameWeather.start_time:type_name -> google.protobuf.Timestamp
 	91,  // 66: cfbd.v1.TeamRecords.total:type_name -> cfbd.v1.TeamRecord
 	91,  // 67: cfbd.v1.TeamRecords.conference_games:type_name -> cfbd.v1.TeamRecord
 	91,  // 68: cfbd.v1.TeamRecords.home_games:type_name -> cfbd.v1.TeamRecord
@@ -13416,44 +13743,44 @@ var file_cfbd_internal_proto_cfbd_proto_depIdxs = []int32{
 	91,  // 70: cfbd.v1.TeamRecords.neutral_site_games:type_name -> cfbd.v1.TeamRecord
 	91,  // 71: cfbd.v1.TeamRecords.regular_season:type_name -> cfbd.v1.TeamRecord
 	91,  // 72: cfbd.v1.TeamRecords.postseason:type_name -> cfbd.v1.TeamRecord
-	118, // 73: cfbd.v1.CalendarWeek.start_date:type_name -> google.protobuf.Timestamp
-	118, // 74: cfbd.v1.CalendarWeek.end_date:type_name -> google.protobuf.Timestamp
-	118, // 75: cfbd.v1.CalendarWeek.first_game_start:type_name -> google.protobuf.Timestamp
-	118, // 76: cfbd.v1.CalendarWeek.last_game_start:type_name -> google.protobuf.Timestamp
-	118, // 77: cfbd.v1.Scoreboard.start_date:type_name -> google.protobuf.Timestamp
-	119, // 78: cfbd.v1.Scoreboard.venue:type_name -> google.protobuf.Struct
-	119, // 79: cfbd.v1.Scoreboard.home_team:type_name -> google.protobuf.Struct
-	119, // 80: cfbd.v1.Scoreboard.away_team:type_name -> google.protobuf.Struct
-	119, // 81: cfbd.v1.Scoreboard.weather:type_name -> google.protobuf.Struct
-	119, // 82: cfbd.v1.Scoreboard.betting:type_name -> google.protobuf.Struct
+	122, // 73: cfbd.v1.CalendarWeek.start_date:type_name -> google.protobuf.Timestamp
+	122, // 74: cfbd.v1.CalendarWeek.end_date:type_name -> google.protobuf.Timestamp
+	122, // 75: cfbd.v1.CalendarWeek.first_game_start:type_name -> google.protobuf.Timestamp
+	122, // 76: cfbd.v1.CalendarWeek.last_game_start:type_name -> google.protobuf.Timestamp
+	122, // 77: cfbd.v1.Scoreboard.start_date:type_name -> google.protobuf.Timestamp
+	94,  // 78: cfbd.v1.Scoreboard.venue:type_name -> cfbd.v1.ScoreboardVenue
+	95,  // 79: cfbd.v1.Scoreboard.home_team:type_name -> cfbd.v1.ScoreboardTeam
+	95,  // 80: cfbd.v1.Scoreboard.away_team:type_name -> cfbd.v1.ScoreboardTeam
+	96,  // 81: cfbd.v1.Scoreboard.weather:type_name -> cfbd.v1.ScoreboardWeather
+	97,  // 82: cfbd.v1.Scoreboard.betting:type_name -> cfbd.v1.ScoreboardBetting
 	3,   // 83: cfbd.v1.Drive.start_time:type_name -> cfbd.v1.ClockInt32
 	3,   // 84: cfbd.v1.Drive.end_time:type_name -> cfbd.v1.ClockInt32
 	3,   // 85: cfbd.v1.Drive.elapsed:type_name -> cfbd.v1.ClockInt32
-	98,  // 86: cfbd.v1.DraftPick.hometown_info:type_name -> cfbd.v1.DraftPickHometownInfo
-	118, // 87: cfbd.v1.Coach.hire_date:type_name -> google.protobuf.Timestamp
-	100, // 88: cfbd.v1.Coach.seasons:type_name -> cfbd.v1.CoachSeason
-	102, // 89: cfbd.v1.TeamPPA.overall:type_name -> cfbd.v1.StatsByQuarter
-	102, // 90: cfbd.v1.TeamPPA.passing:type_name -> cfbd.v1.StatsByQuarter
-	102, // 91: cfbd.v1.TeamPPA.rushing:type_name -> cfbd.v1.StatsByQuarter
-	102, // 92: cfbd.v1.TeamSuccessRates.overall:type_name -> cfbd.v1.StatsByQuarter
-	102, // 93: cfbd.v1.TeamSuccessRates.standard_downs:type_name -> cfbd.v1.StatsByQuarter
-	102, // 94: cfbd.v1.TeamSuccessRates.passing_downs:type_name -> cfbd.v1.StatsByQuarter
-	102, // 95: cfbd.v1.TeamExplosiveness.overall:type_name -> cfbd.v1.StatsByQuarter
-	111, // 96: cfbd.v1.PlayerPPA.average:type_name -> cfbd.v1.PlayerStatsByQuarter
-	111, // 97: cfbd.v1.PlayerPPA.cumulative:type_name -> cfbd.v1.PlayerStatsByQuarter
-	109, // 98: cfbd.v1.AdvancedBoxScoreTeams.field_position:type_name -> cfbd.v1.TeamFieldPosition
-	108, // 99: cfbd.v1.AdvancedBoxScoreTeams.scoring_opportunities:type_name -> cfbd.v1.TeamScoringOpportunities
-	107, // 100: cfbd.v1.AdvancedBoxScoreTeams.havoc:type_name -> cfbd.v1.TeamHavoc
-	106, // 101: cfbd.v1.AdvancedBoxScoreTeams.rushing:type_name -> cfbd.v1.TeamRushingStats
-	105, // 102: cfbd.v1.AdvancedBoxScoreTeams.explosiveness:type_name -> cfbd.v1.TeamExplosiveness
-	104, // 103: cfbd.v1.AdvancedBoxScoreTeams.success_rates:type_name -> cfbd.v1.TeamSuccessRates
-	103, // 104: cfbd.v1.AdvancedBoxScoreTeams.cumulative_ppa:type_name -> cfbd.v1.TeamPPA
-	103, // 105: cfbd.v1.AdvancedBoxScoreTeams.ppa:type_name -> cfbd.v1.TeamPPA
-	112, // 106: cfbd.v1.AdvancedBoxScorePlayers.ppa:type_name -> cfbd.v1.PlayerPPA
-	110, // 107: cfbd.v1.AdvancedBoxScorePlayers.usage:type_name -> cfbd.v1.PlayerGameUsage
-	113, // 108: cfbd.v1.AdvancedBoxScore.game_info:type_name -> cfbd.v1.AdvancedBoxScoreGameInfo
-	114, // 109: cfbd.v1.AdvancedBoxScore.teams:type_name -> cfbd.v1.AdvancedBoxScoreTeams
-	115, // 110: cfbd.v1.AdvancedBoxScore.players:type_name -> cfbd.v1.AdvancedBoxScorePlayers
+	102, // 86: cfbd.v1.DraftPick.hometown_info:type_name -> cfbd.v1.DraftPickHometownInfo
+	122, // 87: cfbd.v1.Coach.hire_date:type_name -> google.protobuf.Timestamp
+	104, // 88: cfbd.v1.Coach.seasons:type_name -> cfbd.v1.CoachSeason
+	106, // 89: cfbd.v1.TeamPPA.overall:type_name -> cfbd.v1.StatsByQuarter
+	106, // 90: cfbd.v1.TeamPPA.passing:type_name -> cfbd.v1.StatsByQuarter
+	106, // 91: cfbd.v1.TeamPPA.rushing:type_name -> cfbd.v1.StatsByQuarter
+	106, // 92: cfbd.v1.TeamSuccessRates.overall:type_name -> cfbd.v1.StatsByQuarter
+	106, // 93: cfbd.v1.TeamSuccessRates.standard_downs:type_name -> cfbd.v1.StatsByQuarter
+	106, // 94: cfbd.v1.TeamSuccessRates.passing_downs:type_name -> cfbd.v1.StatsByQuarter
+	106, // 95: cfbd.v1.TeamExplosiveness.overall:type_name -> cfbd.v1.StatsByQuarter
+	115, // 96: cfbd.v1.PlayerPPA.average:type_name -> cfbd.v1.PlayerStatsByQuarter
+	115, // 97: cfbd.v1.PlayerPPA.cumulative:type_name -> cfbd.v1.PlayerStatsByQuarter
+	113, // 98: cfbd.v1.AdvancedBoxScoreTeams.field_position:type_name -> cfbd.v1.TeamFieldPosition
+	112, // 99: cfbd.v1.AdvancedBoxScoreTeams.scoring_opportunities:type_name -> cfbd.v1.TeamScoringOpportunities
+	111, // 100: cfbd.v1.AdvancedBoxScoreTeams.havoc:type_name -> cfbd.v1.TeamHavoc
+	110, // 101: cfbd.v1.AdvancedBoxScoreTeams.rushing:type_name -> cfbd.v1.TeamRushingStats
+	109, // 102: cfbd.v1.AdvancedBoxScoreTeams.explosiveness:type_name -> cfbd.v1.TeamExplosiveness
+	108, // 103: cfbd.v1.AdvancedBoxScoreTeams.success_rates:type_name -> cfbd.v1.TeamSuccessRates
+	107, // 104: cfbd.v1.AdvancedBoxScoreTeams.cumulative_ppa:type_name -> cfbd.v1.TeamPPA
+	107, // 105: cfbd.v1.AdvancedBoxScoreTeams.ppa:type_name -> cfbd.v1.TeamPPA
+	116, // 106: cfbd.v1.AdvancedBoxScorePlayers.ppa:type_name -> cfbd.v1.PlayerPPA
+	114, // 107: cfbd.v1.AdvancedBoxScorePlayers.usage:type_name -> cfbd.v1.PlayerGameUsage
+	117, // 108: cfbd.v1.AdvancedBoxScore.game_info:type_name -> cfbd.v1.AdvancedBoxScoreGameInfo
+	118, // 109: cfbd.v1.AdvancedBoxScore.teams:type_name -> cfbd.v1.AdvancedBoxScoreTeams
+	119, // 110: cfbd.v1.AdvancedBoxScore.players:type_name -> cfbd.v1.AdvancedBoxScorePlayers
 	111, // [111:111] is the sub-list for method output_type
 	111, // [111:111] is the sub-list for method input_type
 	111, // [111:111] is the sub-list for extension type_name
@@ -13512,20 +13839,23 @@ func file_cfbd_internal_proto_cfbd_proto_init() {
 	file_cfbd_internal_proto_cfbd_proto_msgTypes[87].OneofWrappers = []any{}
 	file_cfbd_internal_proto_cfbd_proto_msgTypes[90].OneofWrappers = []any{}
 	file_cfbd_internal_proto_cfbd_proto_msgTypes[92].OneofWrappers = []any{}
-	file_cfbd_internal_proto_cfbd_proto_msgTypes[94].OneofWrappers = []any{}
 	file_cfbd_internal_proto_cfbd_proto_msgTypes[95].OneofWrappers = []any{}
+	file_cfbd_internal_proto_cfbd_proto_msgTypes[96].OneofWrappers = []any{}
+	file_cfbd_internal_proto_cfbd_proto_msgTypes[97].OneofWrappers = []any{}
+	file_cfbd_internal_proto_cfbd_proto_msgTypes[98].OneofWrappers = []any{}
 	file_cfbd_internal_proto_cfbd_proto_msgTypes[99].OneofWrappers = []any{}
-	file_cfbd_internal_proto_cfbd_proto_msgTypes[100].OneofWrappers = []any{}
-	file_cfbd_internal_proto_cfbd_proto_msgTypes[102].OneofWrappers = []any{}
-	file_cfbd_internal_proto_cfbd_proto_msgTypes[110].OneofWrappers = []any{}
-	file_cfbd_internal_proto_cfbd_proto_msgTypes[111].OneofWrappers = []any{}
+	file_cfbd_internal_proto_cfbd_proto_msgTypes[103].OneofWrappers = []any{}
+	file_cfbd_internal_proto_cfbd_proto_msgTypes[104].OneofWrappers = []any{}
+	file_cfbd_internal_proto_cfbd_proto_msgTypes[106].OneofWrappers = []any{}
+	file_cfbd_internal_proto_cfbd_proto_msgTypes[114].OneofWrappers = []any{}
+	file_cfbd_internal_proto_cfbd_proto_msgTypes[115].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cfbd_internal_proto_cfbd_proto_rawDesc), len(file_cfbd_internal_proto_cfbd_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   117,
+			NumMessages:   121,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
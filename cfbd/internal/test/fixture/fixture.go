@@ -0,0 +1,53 @@
+// Package fixture loads the API responses under internal/test/responses
+// for tests.
+package fixture
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Path returns the path of the response fixture filename.
+func Path(filename string) string {
+	_, file, _, _ := runtime.Caller(0)
+
+	return filepath.Join(filepath.Dir(file), "..", "responses", filename)
+}
+
+// Read returns the contents of the response fixture filename.
+func Read(t *testing.T, filename string) []byte {
+	t.Helper()
+
+	b, err := os.ReadFile(Path(filename))
+	require.NoError(t, err)
+
+	return b
+}
+
+// Load reads the JSON array fixture filename into messages made by newT.
+// Fields the messages do not know are ignored.
+func Load[T proto.Message](
+	t *testing.T, filename string, newT func() T,
+) []T {
+	t.Helper()
+
+	var raws []json.RawMessage
+	require.NoError(t, json.Unmarshal(Read(t, filename), &raws))
+
+	opts := protojson.UnmarshalOptions{DiscardUnknown: true}
+	out := make([]T, 0, len(raws))
+	for _, raw := range raws {
+		m := newT()
+		require.NoError(t, opts.Unmarshal(raw, m))
+		out = append(out, m)
+	}
+
+	return out
+}
@@ -0,0 +1,304 @@
+// Package kickoff joins games, game media and scoreboard entries to the
+// venue they are played at, so that the UTC start times reported by the API
+// can be shown in the stadium's time zone and the viewer's.
+package kickoff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Source is the subset of *cfbd.Client used to build a Joiner.
+type Source interface {
+	GetVenues(ctx context.Context) ([]*cfbd.Venue, error)
+}
+
+var _ Source = (*cfbd.Client)(nil)
+
+// Window is the broadcast window a kickoff falls in.
+type Window int
+
+const (
+	// WindowTBD is a kickoff whose time has not been announced.
+	WindowTBD Window = iota
+	// WindowMorning is a kickoff before 11:00.
+	WindowMorning
+	// WindowNoon is a kickoff from 11:00 until 14:00.
+	WindowNoon
+	// WindowAfternoon is a kickoff from 14:00 until 18:00.
+	WindowAfternoon
+	// WindowNight is a kickoff from 18:00, including kickoffs after
+	// midnight.
+	WindowNight
+	// WindowUnknown is an announced kickoff whose local time is unknown
+	// because the venue or its time zone is.
+	WindowUnknown
+)
+
+// Hours at which the windows begin.
+const (
+	noonStart      = 11
+	afternoonStart = 14
+	nightStart     = 18
+	// nightEnd is the hour before which a kickoff still belongs to the
+	// previous night.
+	nightEnd = 5
+)
+
+func (w Window) String() string {
+	switch w {
+	case WindowTBD:
+		return "TBD"
+	case WindowMorning:
+		return "morning"
+	case WindowNoon:
+		return "noon"
+	case WindowAfternoon:
+		return "afternoon"
+	case WindowNight:
+		return "night"
+	case WindowUnknown:
+		return "unknown"
+	default:
+		return fmt.Sprintf("Window(%d)", int(w))
+	}
+}
+
+// WindowOf returns the window a kickoff at t falls in, judged by the wall
+// clock of t's location.
+func WindowOf(t time.Time) Window {
+	switch h := t.Hour(); {
+	case h < nightEnd:
+		return WindowNight
+	case h < noonStart:
+		return WindowMorning
+	case h < afternoonStart:
+		return WindowNoon
+	case h < nightStart:
+		return WindowAfternoon
+	default:
+		return WindowNight
+	}
+}
+
+// Kickoff is a start time joined to its venue.
+type Kickoff struct {
+	GameID int32
+	// Venue is nil when the entry could not be matched to a venue.
+	Venue *cfbd.Venue
+	// Zone is the venue's time zone, or nil when the venue is unknown or
+	// has no valid time zone.
+	Zone *time.Location
+	// Start is the kickoff in UTC.
+	Start time.Time
+	// Local is the kickoff in the venue's time zone, or in UTC when Zone
+	// is nil.
+	Local time.Time
+	// Viewer is the kickoff in the viewer's time zone.
+	Viewer time.Time
+	// TBD is set when the kickoff time has not been announced. Start,
+	// Local and Viewer then hold the API's placeholder time, of which
+	// only the date is meaningful, or are zero when the entry has no
+	// start date at all.
+	TBD bool
+	// Window is the window of the local kickoff, and ViewerWindow the
+	// window as seen by the viewer. Both are WindowTBD when TBD is set,
+	// and Window is WindowUnknown when Zone is nil.
+	Window       Window
+	ViewerWindow Window
+}
+
+// Option configures a Joiner.
+type Option func(*Joiner)
+
+// WithViewer sets the viewer's time zone. The default is time.Local, which
+// a nil loc keeps.
+func WithViewer(loc *time.Location) Option {
+	return func(j *Joiner) {
+		if loc != nil {
+			j.viewer = loc
+		}
+	}
+}
+
+// WithGames lets the Joiner find the venue of game media and scoreboard
+// entries through their game, since neither carries a venue ID.
+func WithGames(games []*cfbd.Game) Option {
+	return func(j *Joiner) {
+		for _, g := range games {
+			if g.VenueId != nil {
+				j.gameVenues[g.GetId()] = g.GetVenueId()
+			}
+		}
+	}
+}
+
+// Joiner matches entries to venues. It is safe for concurrent use.
+type Joiner struct {
+	venues     map[int32]*cfbd.Venue
+	byName     map[string]*cfbd.Venue
+	gameVenues map[int32]int32
+	viewer     *time.Location
+
+	mu    sync.Mutex
+	zones map[string]*time.Location
+}
+
+// New builds a Joiner over venues as returned by GetVenues.
+func New(venues []*cfbd.Venue, opts ...Option) *Joiner {
+	j := &Joiner{
+		venues:     make(map[int32]*cfbd.Venue, len(venues)),
+		byName:     make(map[string]*cfbd.Venue, len(venues)),
+		gameVenues: make(map[int32]int32),
+		viewer:     time.Local,
+		zones:      make(map[string]*time.Location),
+	}
+	for _, opt := range opts {
+		opt(j)
+	}
+
+	for _, v := range venues {
+		j.venues[v.GetId()] = v
+		j.byName[venueKey(v.GetName(), v.GetCity(), v.GetState())] = v
+	}
+
+	return j
+}
+
+// Build fetches venues from src and returns a Joiner over them.
+func Build(ctx context.Context, src Source, opts ...Option) (*Joiner, error) {
+	venues, err := src.GetVenues(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get venues; %w", err)
+	}
+
+	return New(venues, opts...), nil
+}
+
+// Venue returns the venue with the given ID.
+func (j *Joiner) Venue(id int32) (*cfbd.Venue, bool) {
+	v, ok := j.venues[id]
+	return v, ok
+}
+
+// Game joins a game to its venue by venue_id.
+func (j *Joiner) Game(g *cfbd.Game) Kickoff {
+	var venue *cfbd.Venue
+	if g.VenueId != nil {
+		venue = j.venues[g.GetVenueId()]
+	}
+
+	return j.kickoff(
+		g.GetId(), venue, g.GetStartDate(), g.GetStartTime_TBD(),
+	)
+}
+
+// Media joins a game media entry to the venue of its game. The games must
+// have been given with WithGames.
+func (j *Joiner) Media(m *cfbd.GameMedia) Kickoff {
+	var venue *cfbd.Venue
+	if id, ok := j.gameVenues[m.GetId()]; ok {
+		venue = j.venues[id]
+	}
+
+	return j.kickoff(
+		m.GetId(), venue, m.GetStartTime(), m.GetIsStartTime_TBD(),
+	)
+}
+
+// Scoreboard joins a scoreboard entry to the venue of its game, falling
+// back to the venue with the same name, city and state.
+func (j *Joiner) Scoreboard(s *cfbd.Scoreboard) Kickoff {
+	var venue *cfbd.Venue
+	if id, ok := j.gameVenues[s.GetId()]; ok {
+		venue = j.venues[id]
+	}
+	if venue == nil {
+		sv := s.GetVenue()
+		venue = j.byName[venueKey(sv.GetName(), sv.GetCity(), sv.GetState())]
+	}
+
+	return j.kickoff(
+		s.GetId(), venue, s.GetStartDate(), s.GetStartTime_TBD(),
+	)
+}
+
+// Games joins every game, in order.
+func (j *Joiner) Games(games []*cfbd.Game) []Kickoff {
+	return joinAll(games, j.Game)
+}
+
+// MediaAll joins every game media entry, in order.
+func (j *Joiner) MediaAll(media []*cfbd.GameMedia) []Kickoff {
+	return joinAll(media, j.Media)
+}
+
+// Scoreboards joins every scoreboard entry, in order.
+func (j *Joiner) Scoreboards(entries []*cfbd.Scoreboard) []Kickoff {
+	return joinAll(entries, j.Scoreboard)
+}
+
+func joinAll[T any](items []T, join func(T) Kickoff) []Kickoff {
+	out := make([]Kickoff, 0, len(items))
+	for _, item := range items {
+		out = append(out, join(item))
+	}
+
+	return out
+}
+
+func (j *Joiner) kickoff(
+	gameID int32, venue *cfbd.Venue, start *timestamppb.Timestamp, tbd bool,
+) Kickoff {
+	k := Kickoff{GameID: gameID, Venue: venue, TBD: tbd || start == nil}
+	if venue != nil {
+		k.Zone = j.zone(venue.GetTimezone())
+	}
+	if start == nil {
+		return k
+	}
+	at := start.AsTime()
+	k.Start, k.Local, k.Viewer = at.UTC(), at.UTC(), at.In(j.viewer)
+	if k.TBD {
+		return k
+	}
+	k.ViewerWindow = WindowOf(k.Viewer)
+	k.Window = WindowUnknown
+	if k.Zone != nil {
+		k.Local = at.In(k.Zone)
+		k.Window = WindowOf(k.Local)
+	}
+
+	return k
+}
+
+// zone loads and caches an IANA time zone, returning nil for empty or
+// unknown names.
+func (j *Joiner) zone(name string) *time.Location {
+	if name == "" {
+		return nil
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if loc, ok := j.zones[name]; ok {
+		return loc
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		loc = nil
+	}
+	j.zones[name] = loc
+
+	return loc
+}
+
+func venueKey(name, city, state string) string {
+	return strings.ToLower(name + "|" + city + "|" + state)
+}
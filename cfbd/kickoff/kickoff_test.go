@@ -0,0 +1,171 @@
+package kickoff
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func loadVenues(t *testing.T) []*cfbd.Venue {
+	return fixture.Load(t, "venues.json", func() *cfbd.Venue {
+		return &cfbd.Venue{}
+	})
+}
+
+func zone(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+
+	return loc
+}
+
+func TestGame_Fixture_ShouldUseVenueZone(t *testing.T) {
+	games := fixture.Load(t, "games.json", func() *cfbd.Game {
+		return &cfbd.Game{}
+	})
+	j := New(loadVenues(t), WithViewer(zone(t, "America/Los_Angeles")))
+
+	k := j.Game(games[0])
+
+	require.NotNil(t, k.Venue)
+	assert.Equal(t, "Ohio Stadium", k.Venue.GetName())
+	assert.Equal(t, "America/New_York", k.Zone.String())
+	assert.Equal(t, 12, k.Local.Hour())
+	assert.Equal(t, 9, k.Viewer.Hour())
+	assert.True(t, k.Start.Equal(k.Local))
+	assert.Equal(t, WindowNoon, k.Window)
+	assert.Equal(t, WindowMorning, k.ViewerWindow)
+}
+
+func TestMedia_WithGames_ShouldJoinThroughGame(t *testing.T) {
+	media := fixture.Load(t, "games_media.json", func() *cfbd.GameMedia {
+		return &cfbd.GameMedia{}
+	})
+	venueID := int32(3951)
+	j := New(
+		[]*cfbd.Venue{{
+			Id: venueID, Name: "DKR-Texas Memorial Stadium",
+			Timezone: "America/Chicago",
+		}},
+		WithGames([]*cfbd.Game{{Id: media[0].GetId(), VenueId: &venueID}}),
+		WithViewer(zone(t, "America/New_York")),
+	)
+
+	k := j.MediaAll(media)[0]
+
+	require.NotNil(t, k.Venue)
+	assert.Equal(t, 11, k.Local.Hour())
+	assert.Equal(t, 12, k.Viewer.Hour())
+	assert.Equal(t, WindowNoon, k.Window)
+}
+
+func TestScoreboard_NoGames_ShouldMatchVenueByName(t *testing.T) {
+	entries := fixture.Load(t, "scoreboard.json", func() *cfbd.Scoreboard {
+		return &cfbd.Scoreboard{}
+	})
+	j := New(loadVenues(t), WithViewer(time.UTC))
+
+	k := j.Scoreboards(entries)[0]
+
+	require.NotNil(t, k.Venue)
+	assert.Equal(t, "M&T Bank Stadium", k.Venue.GetName())
+	assert.Equal(t, 15, k.Local.Hour())
+	assert.Equal(t, WindowAfternoon, k.Window)
+	assert.Equal(t, WindowNight, k.ViewerWindow)
+}
+
+func TestGame_UnknownVenue_ShouldStayInUTC(t *testing.T) {
+	id := int32(-1)
+	j := New(nil, WithViewer(time.UTC))
+	// 7:30pm Eastern, which is after midnight in UTC.
+	start := time.Date(2025, 9, 6, 23, 30, 0, 0, time.UTC)
+
+	k := j.Game(&cfbd.Game{
+		Id: 1, VenueId: &id, StartDate: timestamppb.New(start),
+	})
+
+	assert.Nil(t, k.Venue)
+	assert.Nil(t, k.Zone)
+	assert.Equal(t, time.UTC, k.Local.Location())
+	assert.Equal(t, WindowUnknown, k.Window)
+	assert.Equal(t, "unknown", k.Window.String())
+	assert.Equal(t, WindowNight, k.ViewerWindow)
+}
+
+func TestWithViewer_Nil_ShouldKeepDefault(t *testing.T) {
+	j := New(nil, WithViewer(nil))
+
+	k := j.Game(&cfbd.Game{Id: 1, StartDate: timestamppb.Now()})
+
+	assert.Equal(t, time.Local, k.Viewer.Location())
+}
+
+func TestGame_MissingStartDate_ShouldBeTBD(t *testing.T) {
+	venueID := int32(1)
+	j := New([]*cfbd.Venue{{Id: venueID, Timezone: "America/Chicago"}})
+
+	k := j.Game(&cfbd.Game{VenueId: &venueID})
+
+	assert.True(t, k.TBD)
+	assert.True(t, k.Start.IsZero())
+	assert.Equal(t, WindowTBD, k.Window)
+	assert.Equal(t, WindowTBD, k.ViewerWindow)
+}
+
+func TestGame_TBD_ShouldNotClassifyWindow(t *testing.T) {
+	venueID := int32(1)
+	j := New([]*cfbd.Venue{{Id: venueID, Timezone: "Not/AZone"}})
+
+	k := j.Game(&cfbd.Game{VenueId: &venueID, StartTime_TBD: true})
+
+	assert.True(t, k.TBD)
+	assert.Nil(t, k.Zone)
+	assert.Equal(t, WindowTBD, k.Window)
+	assert.Equal(t, WindowTBD, k.ViewerWindow)
+	assert.Equal(t, "TBD", k.Window.String())
+}
+
+func TestWindowOf_ShouldSplitByHour(t *testing.T) {
+	for hour, want := range map[int]Window{
+		0:  WindowNight,
+		4:  WindowNight,
+		9:  WindowMorning,
+		11: WindowNoon,
+		13: WindowNoon,
+		15: WindowAfternoon,
+		18: WindowNight,
+		23: WindowNight,
+	} {
+		at := time.Date(2025, 9, 6, hour, 30, 0, 0, time.UTC)
+		assert.Equal(t, want, WindowOf(at), hour)
+	}
+}
+
+type fakeSource struct {
+	venues []*cfbd.Venue
+	err    error
+}
+
+func (f *fakeSource) GetVenues(context.Context) ([]*cfbd.Venue, error) {
+	return f.venues, f.err
+}
+
+func TestBuild_ShouldFetchVenues(t *testing.T) {
+	j, err := Build(context.Background(),
+		&fakeSource{venues: []*cfbd.Venue{{Id: 7}}})
+	require.NoError(t, err)
+
+	_, ok := j.Venue(7)
+	assert.True(t, ok)
+
+	boom := errors.New("boom")
+	_, err = Build(context.Background(), &fakeSource{err: boom})
+	assert.ErrorIs(t, err, boom)
+}
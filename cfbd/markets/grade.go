@@ -0,0 +1,218 @@
+package markets
+
+import (
+	"fmt"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// ConsensusProvider is the provider name of consensus grades.
+const ConsensusProvider = "consensus"
+
+// Outcome is the result of a bet against the spread.
+type Outcome int
+
+const (
+	// Push is a bet that landed on the spread.
+	Push Outcome = iota
+	// Win is a bet whose side covered.
+	Win
+	// Loss is a bet whose side did not cover.
+	Loss
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case Push:
+		return "push"
+	case Win:
+		return "win"
+	case Loss:
+		return "loss"
+	default:
+		return fmt.Sprintf("Outcome(%d)", int(o))
+	}
+}
+
+// OverUnder is the result of a game against its total.
+type OverUnder int
+
+const (
+	// PushTotal is a game whose points landed on the total.
+	PushTotal OverUnder = iota
+	// Over is a game with more points than the total.
+	Over
+	// Under is a game with fewer points than the total.
+	Under
+)
+
+func (o OverUnder) String() string {
+	switch o {
+	case PushTotal:
+		return "push"
+	case Over:
+		return "over"
+	case Under:
+		return "under"
+	default:
+		return fmt.Sprintf("OverUnder(%d)", int(o))
+	}
+}
+
+// Grade is a line graded against a final score, from the home team's side.
+// Fields whose Has flag is false were missing from the line.
+type Grade struct {
+	Provider string
+
+	HasSpread bool
+	Spread    float64
+	// CoverMargin is the home margin plus the spread; the home team covered
+	// when it is positive.
+	CoverMargin float64
+	// ATS is the result of a bet on the home team.
+	ATS Outcome
+
+	HasTotal bool
+	Total    float64
+	// TotalMargin is the points scored less the total.
+	TotalMargin float64
+	OverUnder   OverUnder
+
+	HasOpen    bool
+	SpreadOpen float64
+	// CLV is the closing line value of a bet on the home team at the
+	// opening spread: how many points better the opening spread was than
+	// the closing one. A bet on the away team has the opposite value.
+	CLV float64
+	// CLVProbability is the chance that the home bet at the opening spread
+	// covers when margins center on the closing spread, less one half.
+	CLVProbability float64
+}
+
+// GameGrade is a game's lines graded against its final score.
+type GameGrade struct {
+	Game   *cfbd.Game
+	Market Market
+	// Margin is the home points less the away points.
+	Margin int32
+	Points int32
+	// Providers holds one grade for each provider's line, and Consensus
+	// grades the consensus line.
+	Providers []Grade
+	Consensus Grade
+}
+
+// Grade returns the grades of provider, or of the consensus for
+// ConsensusProvider.
+func (g GameGrade) Grade(provider string) (Grade, bool) {
+	if provider == ConsensusProvider {
+		return g.Consensus, g.Consensus.HasSpread || g.Consensus.HasTotal
+	}
+	for _, gr := range g.Providers {
+		if gr.Provider == provider {
+			return gr, true
+		}
+	}
+
+	return Grade{}, false
+}
+
+// Grade joins betting games with games by ID and grades every line of the
+// completed ones. Betting games without a completed game are left out.
+func (a *Analyzer) Grade(
+	lines []*cfbd.BettingGame, games []*cfbd.Game,
+) []GameGrade {
+	byID := make(map[int32]*cfbd.Game, len(games))
+	for _, g := range games {
+		if g.GetCompleted() && g.HomePoints != nil && g.AwayPoints != nil {
+			byID[g.GetId()] = g
+		}
+	}
+
+	var out []GameGrade
+	for _, bg := range lines {
+		g, ok := byID[bg.GetId()]
+		if !ok {
+			continue
+		}
+		out = append(out, a.grade(bg, g))
+	}
+
+	return out
+}
+
+func (a *Analyzer) grade(bg *cfbd.BettingGame, g *cfbd.Game) GameGrade {
+	m := a.Analyze(bg)
+	out := GameGrade{
+		Game:   g,
+		Market: m,
+		Margin: g.GetHomePoints() - g.GetAwayPoints(),
+		Points: g.GetHomePoints() + g.GetAwayPoints(),
+	}
+	for _, l := range m.Lines {
+		gr := Grade{Provider: l.Provider}
+		if l.HasSpread {
+			gradeSpread(&gr, out.Margin, l.Spread)
+		}
+		if l.HasMovement {
+			a.gradeOpen(&gr, l.Spread-l.Movement, -l.Movement)
+		}
+		if l.HasTotal {
+			gradeTotal(&gr, out.Points, l.Total)
+		}
+		out.Providers = append(out.Providers, gr)
+	}
+
+	c := m.Consensus
+	out.Consensus = Grade{Provider: ConsensusProvider}
+	if c.Spreads > 0 {
+		gradeSpread(&out.Consensus, out.Margin, c.Spread)
+	}
+	if c.Spreads > 0 && c.Opens > 0 {
+		// Movement compares providers with an opening spread only.
+		a.gradeOpen(&out.Consensus, c.SpreadOpen, -c.Movement)
+	}
+	if c.Totals > 0 {
+		gradeTotal(&out.Consensus, out.Points, c.Total)
+	}
+
+	return out
+}
+
+func gradeSpread(gr *Grade, margin int32, spread float64) {
+	gr.HasSpread, gr.Spread = true, spread
+	gr.CoverMargin = float64(margin) + spread
+	gr.ATS = outcome(gr.CoverMargin)
+}
+
+// gradeOpen sets the opening spread and the closing line value of a home
+// bet at it.
+func (a *Analyzer) gradeOpen(gr *Grade, open, clv float64) {
+	gr.HasOpen, gr.SpreadOpen = true, open
+	gr.CLV = clv
+	gr.CLVProbability = a.model.WinProbability(-gr.CLV) - 0.5
+}
+
+func gradeTotal(gr *Grade, points int32, total float64) {
+	gr.HasTotal, gr.Total = true, total
+	gr.TotalMargin = float64(points) - total
+	switch {
+	case gr.TotalMargin > 0:
+		gr.OverUnder = Over
+	case gr.TotalMargin < 0:
+		gr.OverUnder = Under
+	default:
+		gr.OverUnder = PushTotal
+	}
+}
+
+func outcome(margin float64) Outcome {
+	switch {
+	case margin > 0:
+		return Win
+	case margin < 0:
+		return Loss
+	default:
+		return Push
+	}
+}
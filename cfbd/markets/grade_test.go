@@ -0,0 +1,95 @@
+package markets
+
+import (
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bowl is the final score of the lines fixture's game.
+func bowl() *cfbd.Game {
+	home, away := int32(41), int32(27)
+	return &cfbd.Game{
+		Id:             401778330,
+		Season:         2025,
+		Week:           1,
+		Completed:      true,
+		NeutralSite:    true,
+		HomeTeam:       "Texas",
+		HomeConference: "SEC",
+		HomePoints:     &home,
+		AwayTeam:       "Michigan",
+		AwayConference: "Big Ten",
+		AwayPoints:     &away,
+	}
+}
+
+func TestGrade_Fixture_ShouldGradeConsensus(t *testing.T) {
+	grades := New().Grade(loadLines(t), []*cfbd.Game{bowl()})
+
+	require.Len(t, grades, 1)
+	g := grades[0]
+	assert.Equal(t, int32(14), g.Margin)
+	assert.Equal(t, int32(68), g.Points)
+
+	c := g.Consensus
+	assert.Equal(t, ConsensusProvider, c.Provider)
+	assert.InDelta(t, 9.5, c.CoverMargin, 1e-12)
+	assert.Equal(t, Win, c.ATS)
+	assert.InDelta(t, 17.5, c.TotalMargin, 1e-12)
+	assert.Equal(t, Over, c.OverUnder)
+	assert.True(t, c.HasOpen)
+	assert.InDelta(t, -5.5, c.SpreadOpen, 1e-12)
+	assert.InDelta(t, 1.5, c.CLV, 1e-12)
+	assert.InDelta(t, 0.037346, c.CLVProbability, 1e-6)
+}
+
+func TestGrade_Fixture_ShouldGradeEachProvider(t *testing.T) {
+	g := New().Grade(loadLines(t), []*cfbd.Game{bowl()})[0]
+
+	require.Len(t, g.Providers, 3)
+	bovada, ok := g.Grade("Bovada")
+	require.True(t, ok)
+	assert.InDelta(t, 7, bovada.CoverMargin, 1e-12)
+	assert.InDelta(t, 18, bovada.TotalMargin, 1e-12)
+	assert.InDelta(t, 1.5, bovada.CLV, 1e-12)
+
+	dk, ok := g.Grade("Draft Kings")
+	require.True(t, ok)
+	assert.False(t, dk.HasOpen)
+	assert.Equal(t, Win, dk.ATS)
+
+	_, ok = g.Grade("Caesars")
+	assert.False(t, ok)
+}
+
+func TestGrade_OnTheNumber_ShouldPush(t *testing.T) {
+	g := bowl()
+	spread, total := -14.0, 68.0
+	lines := []*cfbd.BettingGame{{
+		Id:    g.GetId(),
+		Lines: []*cfbd.GameLine{{Spread: &spread, OverUnder: &total}},
+	}}
+
+	gr := New().Grade(lines, []*cfbd.Game{g})[0].Consensus
+
+	assert.Equal(t, Push, gr.ATS)
+	assert.Equal(t, PushTotal, gr.OverUnder)
+	assert.False(t, gr.HasOpen)
+}
+
+func TestGrade_Unplayed_ShouldBeLeftOut(t *testing.T) {
+	g := bowl()
+	g.Completed = false
+
+	assert.Empty(t, New().Grade(loadLines(t), []*cfbd.Game{g}))
+	assert.Empty(t, New().Grade(loadLines(t), nil))
+}
+
+func TestOutcome_String(t *testing.T) {
+	assert.Equal(t, "win", Win.String())
+	assert.Equal(t, "under", Under.String())
+	assert.Equal(t, "Outcome(7)", Outcome(7).String())
+}
@@ -0,0 +1,310 @@
+// Package markets analyzes the betting lines of GetBettingLines: no-vig
+// probabilities from moneylines, consensus spreads and totals across
+// providers, line movement since the open, spread and win probability
+// conversion and providers that stray from the consensus. It also grades
+// lines against final scores, against the spread, over/under and by
+// closing line value, and aggregates the grades by any grouping.
+package markets
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// Source is the subset of *cfbd.Client used by Build.
+type Source interface {
+	GetBettingLines(
+		ctx context.Context, request cfbd.GetBettingLinesRequest,
+	) ([]*cfbd.BettingGame, error)
+}
+
+var _ Source = (*cfbd.Client)(nil)
+
+// Default distances from the consensus, in points, beyond which a
+// provider's line is an outlier.
+const (
+	DefaultSpreadOutlier = 1.5
+	DefaultTotalOutlier  = 2
+)
+
+// Option configures an Analyzer.
+type Option func(*Analyzer)
+
+// WithModel sets the spread model. The default has DefaultSigma.
+func WithModel(m SpreadModel) Option {
+	return func(a *Analyzer) {
+		a.model = m
+	}
+}
+
+// WithMethod sets how the vig is removed from moneylines. The default is
+// Multiplicative.
+func WithMethod(m Method) Option {
+	return func(a *Analyzer) {
+		a.method = m
+	}
+}
+
+// WithMean takes the consensus as the mean of the providers' lines rather
+// than the median.
+func WithMean() Option {
+	return func(a *Analyzer) {
+		a.mean = true
+	}
+}
+
+// WithOutliers sets how far, in points, a provider's spread and total may
+// be from the consensus before it is flagged.
+func WithOutliers(spread, total float64) Option {
+	return func(a *Analyzer) {
+		a.spreadOutlier, a.totalOutlier = spread, total
+	}
+}
+
+// Line is one provider's line for a game. Fields whose Has flag is false
+// were missing from the provider's line.
+type Line struct {
+	Provider string
+
+	HasSpread bool
+	Spread    float64
+	// Movement is the spread less the opening spread; a negative movement
+	// is towards the home team.
+	HasMovement bool
+	Movement    float64
+	// SpreadProbability is the home win probability the spread implies
+	// under the model.
+	SpreadProbability float64
+	// SpreadDeviation is the spread less the consensus spread.
+	SpreadDeviation float64
+	SpreadOutlier   bool
+
+	HasTotal bool
+	Total    float64
+	// TotalMovement is the total less the opening total.
+	HasTotalMovement bool
+	TotalMovement    float64
+	// TotalDeviation is the total less the consensus total.
+	TotalDeviation float64
+	TotalOutlier   bool
+
+	// NoVig holds the moneyline probabilities with the vig removed.
+	HasMoneyline bool
+	NoVig        Probabilities
+}
+
+// Consensus is a game's line across providers. Counts of zero mean no
+// provider had the line.
+type Consensus struct {
+	Spreads    int
+	Spread     float64
+	SpreadOpen float64
+	// Movement is the consensus spread less the consensus opening spread,
+	// over providers with an opening spread.
+	Opens    int
+	Movement float64
+	Totals   int
+	Total    float64
+	// Moneylines counts providers with both moneylines, and
+	// HomeProbability is the mean of their no-vig home probabilities.
+	Moneylines      int
+	HomeProbability float64
+	// SpreadProbability is the home win probability the consensus spread
+	// implies under the model.
+	SpreadProbability float64
+}
+
+// Market is the analysis of one game's lines.
+type Market struct {
+	Game      *cfbd.BettingGame
+	Lines     []Line
+	Consensus Consensus
+}
+
+// Outliers returns the providers whose spread or total is an outlier.
+func (m Market) Outliers() []string {
+	var out []string
+	for _, l := range m.Lines {
+		if l.SpreadOutlier || l.TotalOutlier {
+			out = append(out, l.Provider)
+		}
+	}
+
+	return out
+}
+
+// Analyzer analyzes betting games.
+type Analyzer struct {
+	model         SpreadModel
+	method        Method
+	mean          bool
+	spreadOutlier float64
+	totalOutlier  float64
+}
+
+// New returns an analyzer.
+func New(opts ...Option) *Analyzer {
+	a := &Analyzer{
+		model:         NewSpreadModel(DefaultSigma),
+		spreadOutlier: DefaultSpreadOutlier,
+		totalOutlier:  DefaultTotalOutlier,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// Build fetches betting lines from src and analyzes every game.
+func Build(
+	ctx context.Context, src Source, request cfbd.GetBettingLinesRequest,
+	opts ...Option,
+) ([]Market, error) {
+	games, err := src.GetBettingLines(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("could not get betting lines; %w", err)
+	}
+
+	return New(opts...).AnalyzeAll(games), nil
+}
+
+// AnalyzeAll analyzes every game, in order.
+func (a *Analyzer) AnalyzeAll(games []*cfbd.BettingGame) []Market {
+	out := make([]Market, 0, len(games))
+	for _, bg := range games {
+		out = append(out, a.Analyze(bg))
+	}
+
+	return out
+}
+
+// Analyze analyzes the lines of one game.
+func (a *Analyzer) Analyze(bg *cfbd.BettingGame) Market {
+	m := Market{Game: bg, Consensus: a.consensus(bg)}
+	for _, gl := range bg.GetLines() {
+		m.Lines = append(m.Lines, a.line(gl, m.Consensus))
+	}
+
+	return m
+}
+
+func (a *Analyzer) line(gl *cfbd.GameLine, c Consensus) Line {
+	l := Line{Provider: gl.GetProvider()}
+	if gl.Spread != nil {
+		l.HasSpread, l.Spread = true, gl.GetSpread()
+		l.SpreadProbability = a.model.WinProbability(l.Spread)
+		l.SpreadDeviation = l.Spread - c.Spread
+		l.SpreadOutlier = math.Abs(l.SpreadDeviation) > a.spreadOutlier
+	}
+	if gl.Spread != nil && gl.SpreadOpen != nil {
+		l.HasMovement = true
+		l.Movement = gl.GetSpread() - gl.GetSpreadOpen()
+	}
+	if gl.OverUnder != nil {
+		l.HasTotal, l.Total = true, gl.GetOverUnder()
+		l.TotalDeviation = l.Total - c.Total
+		l.TotalOutlier = math.Abs(l.TotalDeviation) > a.totalOutlier
+	}
+	if gl.OverUnder != nil && gl.OverUnderOpen != nil {
+		l.HasTotalMovement = true
+		l.TotalMovement = gl.GetOverUnder() - gl.GetOverUnderOpen()
+	}
+	l.NoVig, l.HasMoneyline = noVig(gl, a.method)
+
+	return l
+}
+
+func (a *Analyzer) consensus(bg *cfbd.BettingGame) Consensus {
+	var c Consensus
+	all := spreads(bg)
+	c.Spreads = len(all)
+	c.Spread, _ = a.average(all)
+	c.SpreadProbability = a.model.WinProbability(c.Spread)
+
+	var closes, opens, totals []float64
+	var probability float64
+	for _, gl := range bg.GetLines() {
+		if gl.Spread != nil && gl.SpreadOpen != nil {
+			closes = append(closes, gl.GetSpread())
+			opens = append(opens, gl.GetSpreadOpen())
+		}
+		if gl.OverUnder != nil {
+			totals = append(totals, gl.GetOverUnder())
+		}
+		if p, ok := noVig(gl, a.method); ok {
+			probability += p.Home
+			c.Moneylines++
+		}
+	}
+
+	c.Opens = len(opens)
+	c.SpreadOpen, _ = a.average(opens)
+	if closed, ok := a.average(closes); ok {
+		c.Movement = closed - c.SpreadOpen
+	}
+	c.Totals = len(totals)
+	c.Total, _ = a.average(totals)
+	if c.Moneylines > 0 {
+		c.HomeProbability = probability / float64(c.Moneylines)
+	}
+
+	return c
+}
+
+func (a *Analyzer) average(values []float64) (float64, bool) {
+	if a.mean {
+		return mean(values)
+	}
+
+	return median(values)
+}
+
+// spreads returns the providers' spreads for a game.
+func spreads(bg *cfbd.BettingGame) []float64 {
+	var out []float64
+	for _, gl := range bg.GetLines() {
+		if gl.Spread != nil {
+			out = append(out, gl.GetSpread())
+		}
+	}
+
+	return out
+}
+
+func noVig(gl *cfbd.GameLine, method Method) (Probabilities, bool) {
+	if gl.HomeMoneyline == nil || gl.AwayMoneyline == nil {
+		return Probabilities{}, false
+	}
+
+	return RemoveVig(gl.GetHomeMoneyline(), gl.GetAwayMoneyline(), method)
+}
+
+func mean(values []float64) (float64, bool) {
+	if len(values) == 0 {
+		return 0, false
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+
+	return sum / float64(len(values)), true
+}
+
+func median(values []float64) (float64, bool) {
+	if len(values) == 0 {
+		return 0, false
+	}
+	sorted := slices.Sorted(slices.Values(values))
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid], true
+	}
+
+	return (sorted[mid-1] + sorted[mid]) / 2, true
+}
@@ -0,0 +1,106 @@
+package markets
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadLines(t *testing.T) []*cfbd.BettingGame {
+	return fixture.Load(t, "lines.json", func() *cfbd.BettingGame {
+		return &cfbd.BettingGame{}
+	})
+}
+
+func TestAnalyze_Fixture_ShouldBuildConsensus(t *testing.T) {
+	m := New().Analyze(loadLines(t)[0])
+
+	c := m.Consensus
+	assert.Equal(t, 3, c.Spreads)
+	assert.InDelta(t, -4.5, c.Spread, 1e-12)
+	assert.Equal(t, 1, c.Opens)
+	assert.InDelta(t, -5.5, c.SpreadOpen, 1e-12)
+	assert.InDelta(t, -1.5, c.Movement, 1e-12)
+	assert.Equal(t, 3, c.Totals)
+	assert.InDelta(t, 50.5, c.Total, 1e-12)
+	assert.Equal(t, 2, c.Moneylines)
+	assert.InDelta(t, 0.643804, c.HomeProbability, 1e-6)
+	assert.InDelta(t, NewSpreadModel(DefaultSigma).WinProbability(-4.5),
+		c.SpreadProbability, 1e-12)
+}
+
+func TestAnalyze_Fixture_ShouldDescribeEachProvider(t *testing.T) {
+	m := New().Analyze(loadLines(t)[0])
+
+	require.Len(t, m.Lines, 3)
+	bovada := m.Lines[0]
+	assert.Equal(t, "Bovada", bovada.Provider)
+	assert.True(t, bovada.HasMovement)
+	assert.InDelta(t, -1.5, bovada.Movement, 1e-12)
+	assert.True(t, bovada.HasTotalMovement)
+	assert.InDelta(t, 4, bovada.TotalMovement, 1e-12)
+	assert.InDelta(t, -2.5, bovada.SpreadDeviation, 1e-12)
+	assert.True(t, bovada.SpreadOutlier)
+	assert.False(t, bovada.TotalOutlier)
+	assert.True(t, bovada.HasMoneyline)
+	assert.InDelta(t, 0.650704, bovada.NoVig.Home, 1e-6)
+
+	dk := m.Lines[1]
+	assert.False(t, dk.HasMovement)
+	assert.False(t, dk.HasMoneyline)
+	assert.False(t, dk.SpreadOutlier)
+
+	assert.Equal(t, []string{"Bovada"}, m.Outliers())
+}
+
+func TestAnalyze_Options_ShouldChangeConsensusAndThresholds(t *testing.T) {
+	bg := loadLines(t)[0]
+
+	mean := New(WithMean()).Analyze(bg)
+	loose := New(WithOutliers(3, 3)).Analyze(bg)
+
+	assert.InDelta(t, -31.0/6, mean.Consensus.Spread, 1e-12)
+	assert.Empty(t, loose.Outliers())
+}
+
+func TestAnalyze_NoLines_ShouldLeaveCountsZero(t *testing.T) {
+	m := New().Analyze(&cfbd.BettingGame{Id: 1})
+
+	assert.Empty(t, m.Lines)
+	assert.Zero(t, m.Consensus.Spreads)
+	assert.Zero(t, m.Consensus.Moneylines)
+}
+
+type fakeSource struct {
+	games []*cfbd.BettingGame
+	err   error
+	got   cfbd.GetBettingLinesRequest
+}
+
+func (f *fakeSource) GetBettingLines(
+	_ context.Context, request cfbd.GetBettingLinesRequest,
+) ([]*cfbd.BettingGame, error) {
+	f.got = request
+	return f.games, f.err
+}
+
+func TestBuild_ShouldAnalyzeEveryGame(t *testing.T) {
+	src := &fakeSource{games: loadLines(t)}
+	request := cfbd.GetBettingLinesRequest{Year: 2025}
+
+	markets, err := Build(context.Background(), src, request)
+	require.NoError(t, err)
+
+	assert.Equal(t, request, src.got)
+	require.Len(t, markets, 1)
+	assert.Equal(t, int32(401778330), markets[0].Game.GetId())
+
+	boom := errors.New("boom")
+	_, err = Build(context.Background(), &fakeSource{err: boom}, request)
+	assert.ErrorIs(t, err, boom)
+}
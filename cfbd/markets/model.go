@@ -0,0 +1,129 @@
+package markets
+
+import (
+	"errors"
+	"math"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// ErrNoData is returned when there are no games to calibrate a model on.
+var ErrNoData = errors.New("no games to calibrate on")
+
+// DefaultSigma is the standard deviation, in points, of final margins
+// around the spread in college football.
+const DefaultSigma = 16
+
+// The golden section search of FitMoneylines, over standard deviations
+// from minSigma to maxSigma.
+const (
+	minSigma      = 1
+	maxSigma      = 50
+	fitIterations = 100
+	goldenSection = 0.6180339887498949
+)
+
+// SpreadModel converts between a home spread and the home team's win
+// probability, taking the final margin to be normally distributed around
+// the spread.
+type SpreadModel struct {
+	// Sigma is the standard deviation of the margin, in points.
+	Sigma float64
+}
+
+// NewSpreadModel returns a model with standard deviation sigma, or
+// DefaultSigma when sigma is not positive.
+func NewSpreadModel(sigma float64) SpreadModel {
+	if sigma <= 0 {
+		sigma = DefaultSigma
+	}
+
+	return SpreadModel{Sigma: sigma}
+}
+
+// WinProbability returns the home team's chance of winning given its
+// spread. A negative spread favors the home team.
+func (m SpreadModel) WinProbability(spread float64) float64 {
+	return 0.5 * math.Erfc(spread/(m.Sigma*math.Sqrt2))
+}
+
+// Spread returns the home spread that gives the home team probability p of
+// winning, which must be strictly between 0 and 1.
+func (m SpreadModel) Spread(p float64) float64 {
+	return -m.Sigma * math.Sqrt2 * math.Erfinv(2*p-1)
+}
+
+// FitResults calibrates a model on the final scores of games: sigma is the
+// root mean square of final margins around the consensus spread, or
+// DefaultSigma when every margin matched its spread.
+func FitResults(games []*cfbd.BettingGame) (SpreadModel, error) {
+	var sum float64
+	var n int
+	for _, bg := range games {
+		spread, ok := median(spreads(bg))
+		if !ok || bg.HomeScore == nil || bg.AwayScore == nil {
+			continue
+		}
+		// The margin is expected to be minus the spread.
+		miss := float64(bg.GetHomeScore()-bg.GetAwayScore()) + spread
+		sum += miss * miss
+		n++
+	}
+	if n == 0 {
+		return SpreadModel{}, ErrNoData
+	}
+
+	return NewSpreadModel(math.Sqrt(sum / float64(n))), nil
+}
+
+// FitMoneylines calibrates a model on the market itself: sigma is the one
+// whose spread probabilities best match, in squared error, the
+// multiplicative no-vig moneyline probabilities of each provider.
+func FitMoneylines(games []*cfbd.BettingGame) (SpreadModel, error) {
+	type point struct{ spread, p float64 }
+	var points []point
+	for _, bg := range games {
+		for _, l := range bg.GetLines() {
+			p, ok := noVig(l, Multiplicative)
+			if ok && l.Spread != nil {
+				points = append(points, point{l.GetSpread(), p.Home})
+			}
+		}
+	}
+	if len(points) == 0 {
+		return SpreadModel{}, ErrNoData
+	}
+
+	loss := func(sigma float64) float64 {
+		m := SpreadModel{Sigma: sigma}
+		var sum float64
+		for _, pt := range points {
+			d := m.WinProbability(pt.spread) - pt.p
+			sum += d * d
+		}
+		return sum
+	}
+
+	return SpreadModel{Sigma: minimize(loss, minSigma, maxSigma)}, nil
+}
+
+// minimize finds the minimum of a unimodal f on [lo, hi] by golden section
+// search.
+func minimize(f func(float64) float64, lo, hi float64) float64 {
+	a := hi - goldenSection*(hi-lo)
+	b := lo + goldenSection*(hi-lo)
+	fa, fb := f(a), f(b)
+	for range fitIterations {
+		if fa < fb {
+			hi, b, fb = b, a, fa
+			a = hi - goldenSection*(hi-lo)
+			fa = f(a)
+		} else {
+			lo, a, fa = a, b, fb
+			b = lo + goldenSection*(hi-lo)
+			fb = f(b)
+		}
+	}
+
+	return (lo + hi) / 2
+}
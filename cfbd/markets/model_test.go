@@ -0,0 +1,70 @@
+package markets
+
+import (
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpreadModel_ShouldConvertBothWays(t *testing.T) {
+	m := NewSpreadModel(0)
+
+	assert.InDelta(t, DefaultSigma, m.Sigma, 1e-12)
+	assert.InDelta(t, 0.5, m.WinProbability(0), 1e-12)
+	// One standard deviation of favoritism.
+	assert.InDelta(t, 0.841345, m.WinProbability(-16), 1e-6)
+	assert.InDelta(t, -7, m.Spread(m.WinProbability(-7)), 1e-9)
+	assert.InDelta(t, 3.5, m.Spread(m.WinProbability(3.5)), 1e-9)
+}
+
+func scored(spread float64, home, away int32) *cfbd.BettingGame {
+	return &cfbd.BettingGame{
+		HomeScore: &home,
+		AwayScore: &away,
+		Lines:     []*cfbd.GameLine{{Spread: &spread}},
+	}
+}
+
+func TestFitResults_ShouldUseMarginAroundSpread(t *testing.T) {
+	m, err := FitResults([]*cfbd.BettingGame{
+		scored(-7, 17, 0),           // misses by 10
+		scored(3, 10, 20),           // misses by -7
+		{Lines: []*cfbd.GameLine{}}, // not played
+	})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 8.631338, m.Sigma, 1e-6)
+
+	_, err = FitResults(nil)
+	assert.ErrorIs(t, err, ErrNoData)
+}
+
+func TestFitResults_ExactSpreads_ShouldUseDefaultSigma(t *testing.T) {
+	m, err := FitResults([]*cfbd.BettingGame{scored(-7, 14, 7)})
+	require.NoError(t, err)
+
+	assert.InDelta(t, DefaultSigma, m.Sigma, 1e-12)
+	assert.InDelta(t, 0.5, m.WinProbability(0), 1e-12)
+}
+
+func TestFitMoneylines_ShouldRecoverSigma(t *testing.T) {
+	truth := NewSpreadModel(13)
+	var games []*cfbd.BettingGame
+	for _, spread := range []float64{-21, -10, -3, 4, 14} {
+		p := truth.WinProbability(spread)
+		home, away := Moneyline(p), Moneyline(1-p)
+		games = append(games, &cfbd.BettingGame{Lines: []*cfbd.GameLine{{
+			Spread: &spread, HomeMoneyline: &home, AwayMoneyline: &away,
+		}}})
+	}
+
+	m, err := FitMoneylines(games)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 13, m.Sigma, 1e-3)
+
+	_, err = FitMoneylines([]*cfbd.BettingGame{scored(-3, 7, 0)})
+	assert.ErrorIs(t, err, ErrNoData)
+}
@@ -0,0 +1,122 @@
+package markets
+
+import (
+	"fmt"
+	"math"
+)
+
+// Method is a way of removing the bookmaker's margin, the vig, from
+// implied probabilities.
+type Method int
+
+const (
+	// Multiplicative scales both implied probabilities by their sum.
+	Multiplicative Method = iota
+	// Additive takes an equal share of the overround off each side.
+	Additive
+	// Power raises both implied probabilities to the power that makes them
+	// sum to one, which takes more off the longshot.
+	Power
+)
+
+func (m Method) String() string {
+	switch m {
+	case Multiplicative:
+		return "multiplicative"
+	case Additive:
+		return "additive"
+	case Power:
+		return "power"
+	default:
+		return fmt.Sprintf("Method(%d)", int(m))
+	}
+}
+
+const (
+	// evenOdds is the American odds of a bet that wins what it risks.
+	evenOdds = 100
+	// powerIterations bounds the bisection of the power method.
+	powerIterations  = 100
+	powerMaxExponent = 20
+)
+
+// Probabilities are the implied chances of each side of a moneyline.
+type Probabilities struct {
+	Home float64
+	Away float64
+	// Overround is how far the implied probabilities summed above one
+	// before the vig was removed.
+	Overround float64
+}
+
+// ImpliedProbability returns the break-even probability of American odds,
+// vig included. ok is false for odds between -100 and 100, which are not
+// valid American odds.
+func ImpliedProbability(moneyline float64) (float64, bool) {
+	switch {
+	case moneyline <= -evenOdds:
+		return -moneyline / (evenOdds - moneyline), true
+	case moneyline >= evenOdds:
+		return evenOdds / (moneyline + evenOdds), true
+	default:
+		return 0, false
+	}
+}
+
+// Moneyline returns the fair American odds of probability p, which must be
+// strictly between 0 and 1.
+func Moneyline(p float64) float64 {
+	if p >= 0.5 {
+		return -evenOdds * p / (1 - p)
+	}
+
+	return evenOdds * (1 - p) / p
+}
+
+// RemoveVig returns the no-vig probabilities of a pair of moneylines. ok is
+// false when either moneyline is not valid American odds.
+func RemoveVig(home, away float64, method Method) (Probabilities, bool) {
+	ph, ok := ImpliedProbability(home)
+	if !ok {
+		return Probabilities{}, false
+	}
+	pa, ok := ImpliedProbability(away)
+	if !ok {
+		return Probabilities{}, false
+	}
+
+	out := Probabilities{Overround: ph + pa - 1}
+	switch method {
+	case Additive:
+		out.Home = clamp(ph - out.Overround/2)
+		out.Away = 1 - out.Home
+	case Power:
+		k := powerExponent(ph, pa)
+		out.Home = math.Pow(ph, k)
+		out.Away = 1 - out.Home
+	default:
+		out.Home = ph / (ph + pa)
+		out.Away = pa / (ph + pa)
+	}
+
+	return out, true
+}
+
+// powerExponent finds k such that ph^k + pa^k = 1 by bisection.
+func powerExponent(ph, pa float64) float64 {
+	lo, hi := 0.0, float64(powerMaxExponent)
+	for range powerIterations {
+		k := (lo + hi) / 2
+		if math.Pow(ph, k)+math.Pow(pa, k) > 1 {
+			lo = k
+		} else {
+			hi = k
+		}
+	}
+
+	return (lo + hi) / 2
+}
+
+func clamp(p float64) float64 {
+	return min(max(p, 0), 1)
+}
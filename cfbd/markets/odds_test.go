@@ -0,0 +1,59 @@
+package markets
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImpliedProbability_ShouldConvertAmericanOdds(t *testing.T) {
+	fav, ok := ImpliedProbability(-210)
+	assert.True(t, ok)
+	assert.InDelta(t, 210.0/310, fav, 1e-12)
+
+	dog, ok := ImpliedProbability(175)
+	assert.True(t, ok)
+	assert.InDelta(t, 100.0/275, dog, 1e-12)
+
+	_, ok = ImpliedProbability(50)
+	assert.False(t, ok)
+}
+
+func TestMoneyline_ShouldInvertImpliedProbability(t *testing.T) {
+	for _, ml := range []float64{-400, -110, 150, 900} {
+		p, ok := ImpliedProbability(ml)
+		assert.True(t, ok)
+		assert.InDelta(t, ml, Moneyline(p), 1e-9)
+	}
+}
+
+func TestRemoveVig_EachMethod_ShouldSumToOne(t *testing.T) {
+	mult, ok := RemoveVig(-210, 175, Multiplicative)
+	assert.True(t, ok)
+	assert.InDelta(t, 0.650704, mult.Home, 1e-6)
+	assert.InDelta(t, 0.041056, mult.Overround, 1e-6)
+
+	add, _ := RemoveVig(-210, 175, Additive)
+	assert.InDelta(t, 0.656891, add.Home, 1e-6)
+
+	pow, _ := RemoveVig(-210, 175, Power)
+	ph, _ := ImpliedProbability(-210)
+	pa, _ := ImpliedProbability(175)
+	k := math.Log(pow.Home) / math.Log(ph)
+	assert.InDelta(t, 1, math.Pow(ph, k)+math.Pow(pa, k), 1e-9)
+	// The power method takes more off the longshot.
+	assert.Greater(t, pow.Home, mult.Home)
+
+	for _, p := range []Probabilities{mult, add, pow} {
+		assert.InDelta(t, 1, p.Home+p.Away, 1e-9)
+	}
+
+	_, ok = RemoveVig(-210, 0, Multiplicative)
+	assert.False(t, ok)
+}
+
+func TestMethod_String(t *testing.T) {
+	assert.Equal(t, "power", Power.String())
+	assert.Equal(t, "Method(9)", Method(9).String())
+}
@@ -0,0 +1,264 @@
+package markets
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Venue and role keys of ByVenue and ByRole.
+const (
+	Home     = "home"
+	Away     = "away"
+	Neutral  = "neutral"
+	Favorite = "favorite"
+	Underdog = "underdog"
+	PickEm   = "pick'em"
+	NoSpread = "no spread"
+)
+
+// Side is one team's side of a graded line.
+type Side struct {
+	GameID             int32
+	Season             int32
+	Week               int32
+	Provider           string
+	Team               string
+	Conference         string
+	Opponent           string
+	OpponentConference string
+	Home               bool
+	Neutral            bool
+
+	// Spread, CoverMargin, ATS, CLV and CLVProbability are from the
+	// team's side.
+	HasSpread      bool
+	Spread         float64
+	CoverMargin    float64
+	ATS            Outcome
+	HasTotal       bool
+	TotalMargin    float64
+	OverUnder      OverUnder
+	HasOpen        bool
+	CLV            float64
+	CLVProbability float64
+}
+
+// Sides returns the home and away sides of provider's grade, or of the
+// consensus for ConsensusProvider, or nil when the provider has no line.
+func (g GameGrade) Sides(provider string) []Side {
+	gr, ok := g.Grade(provider)
+	if !ok {
+		return nil
+	}
+
+	home := Side{
+		GameID:             g.Game.GetId(),
+		Season:             g.Game.GetSeason(),
+		Week:               g.Game.GetWeek(),
+		Provider:           provider,
+		Team:               g.Game.GetHomeTeam(),
+		Conference:         g.Game.GetHomeConference(),
+		Opponent:           g.Game.GetAwayTeam(),
+		OpponentConference: g.Game.GetAwayConference(),
+		Home:               !g.Game.GetNeutralSite(),
+		Neutral:            g.Game.GetNeutralSite(),
+		HasSpread:          gr.HasSpread,
+		Spread:             gr.Spread,
+		CoverMargin:        gr.CoverMargin,
+		ATS:                gr.ATS,
+		HasTotal:           gr.HasTotal,
+		TotalMargin:        gr.TotalMargin,
+		OverUnder:          gr.OverUnder,
+		HasOpen:            gr.HasOpen,
+		CLV:                gr.CLV,
+		CLVProbability:     gr.CLVProbability,
+	}
+
+	away := home
+	away.Team, away.Opponent = home.Opponent, home.Team
+	away.Conference, away.OpponentConference =
+		home.OpponentConference, home.Conference
+	away.Home = false
+	away.Spread, away.CoverMargin = -home.Spread, -home.CoverMargin
+	away.ATS = outcome(away.CoverMargin)
+	away.CLV = -home.CLV
+	away.CLVProbability = -home.CLVProbability
+
+	return []Side{home, away}
+}
+
+// Sides returns the sides of provider's grade, or of the consensus for
+// ConsensusProvider, in every game.
+func Sides(grades []GameGrade, provider string) []Side {
+	var out []Side
+	for _, g := range grades {
+		out = append(out, g.Sides(provider)...)
+	}
+
+	return out
+}
+
+// ByTeam groups sides by team.
+func ByTeam(s Side) string {
+	return s.Team
+}
+
+// ByConference groups sides by the team's conference.
+func ByConference(s Side) string {
+	return s.Conference
+}
+
+// ByVenue groups sides into Home, Away and Neutral.
+func ByVenue(s Side) string {
+	switch {
+	case s.Neutral:
+		return Neutral
+	case s.Home:
+		return Home
+	default:
+		return Away
+	}
+}
+
+// ByRole groups sides into Favorite, Underdog and PickEm by the spread,
+// and sides without a spread into NoSpread.
+func ByRole(s Side) string {
+	switch {
+	case !s.HasSpread:
+		return NoSpread
+	case s.Spread < 0:
+		return Favorite
+	case s.Spread > 0:
+		return Underdog
+	default:
+		return PickEm
+	}
+}
+
+// Summary aggregates the sides of one group.
+type Summary struct {
+	Key string
+	// Wins, Losses and Pushes count sides with a spread against it.
+	Wins   int
+	Losses int
+	Pushes int
+	// CoverPct is wins over wins and losses, or 0 without either.
+	CoverPct        float64
+	MeanCoverMargin float64
+	// Overs, Unders and TotalPushes count sides with a total.
+	Overs       int
+	Unders      int
+	TotalPushes int
+	// CLVs counts sides with an opening spread, and MeanCLV and
+	// MeanCLVProbability average their closing line values.
+	CLVs               int
+	MeanCLV            float64
+	MeanCLVProbability float64
+}
+
+// Summarize aggregates sides by key, ordered by key. Keys can combine the
+// By functions, such as team and role. Every game has two sides, so a key
+// that holds both, such as ByVenue at neutral sites, counts the game's
+// total twice and its spread once as a win and once as a loss.
+func Summarize(sides []Side, key func(Side) string) []Summary {
+	return summarize(sides, key)
+}
+
+// SummarizeProviders aggregates each provider's lines, ordered by
+// provider. Every game counts once, from the favorite's side, so Wins are
+// favorite covers; pick'em games count only towards totals.
+func SummarizeProviders(grades []GameGrade) []Summary {
+	var sides []Side
+	for _, g := range grades {
+		for _, gr := range g.Providers {
+			sides = append(sides, g.favorite(gr.Provider))
+		}
+	}
+
+	return summarize(sides, func(s Side) string { return s.Provider })
+}
+
+// favorite returns the favorite's side of provider's line. Without a
+// favorite it returns the home side with neither spread nor opening.
+func (g GameGrade) favorite(provider string) Side {
+	sides := g.Sides(provider)
+	home, away := sides[0], sides[1]
+	switch {
+	case !home.HasSpread || home.Spread == 0:
+		home.HasSpread, home.HasOpen = false, false
+		return home
+	case home.Spread < 0:
+		return home
+	default:
+		return away
+	}
+}
+
+func summarize(sides []Side, key func(Side) string) []Summary {
+	byKey := make(map[string]*Summary)
+	for _, s := range sides {
+		k := key(s)
+		sum, ok := byKey[k]
+		if !ok {
+			sum = &Summary{Key: k}
+			byKey[k] = sum
+		}
+		sum.add(s)
+	}
+
+	out := make([]Summary, 0, len(byKey))
+	for _, sum := range byKey {
+		out = append(out, sum.finish())
+	}
+	slices.SortFunc(out, func(a, b Summary) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+
+	return out
+}
+
+func (s *Summary) add(side Side) {
+	if side.HasSpread {
+		switch side.ATS {
+		case Win:
+			s.Wins++
+		case Loss:
+			s.Losses++
+		case Push:
+			s.Pushes++
+		}
+		s.MeanCoverMargin += side.CoverMargin
+	}
+	if side.HasTotal {
+		switch side.OverUnder {
+		case Over:
+			s.Overs++
+		case Under:
+			s.Unders++
+		case PushTotal:
+			s.TotalPushes++
+		}
+	}
+	if side.HasOpen {
+		s.CLVs++
+		s.MeanCLV += side.CLV
+		s.MeanCLVProbability += side.CLVProbability
+	}
+}
+
+// finish turns the sums of add into means.
+func (s *Summary) finish() Summary {
+	out := *s
+	if n := out.Wins + out.Losses + out.Pushes; n > 0 {
+		out.MeanCoverMargin /= float64(n)
+	}
+	if decided := out.Wins + out.Losses; decided > 0 {
+		out.CoverPct = float64(out.Wins) / float64(decided)
+	}
+	if out.CLVs > 0 {
+		out.MeanCLV /= float64(out.CLVs)
+		out.MeanCLVProbability /= float64(out.CLVs)
+	}
+
+	return out
+}
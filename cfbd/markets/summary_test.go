@@ -0,0 +1,103 @@
+package markets
+
+import (
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSides_ShouldMirrorTheAwayTeam(t *testing.T) {
+	g := New().Grade(loadLines(t), []*cfbd.Game{bowl()})[0]
+
+	sides := g.Sides("Bovada")
+
+	require.Len(t, sides, 2)
+	texas, michigan := sides[0], sides[1]
+	assert.Equal(t, "Texas", texas.Team)
+	assert.True(t, texas.Neutral)
+	assert.False(t, texas.Home)
+	assert.Equal(t, "Michigan", michigan.Team)
+	assert.Equal(t, "Big Ten", michigan.Conference)
+	assert.Equal(t, "SEC", michigan.OpponentConference)
+	assert.InDelta(t, 7, michigan.Spread, 1e-12)
+	assert.InDelta(t, -7, michigan.CoverMargin, 1e-12)
+	assert.Equal(t, Loss, michigan.ATS)
+	assert.Equal(t, Over, michigan.OverUnder)
+	assert.InDelta(t, -1.5, michigan.CLV, 1e-12)
+	assert.Nil(t, g.Sides("Caesars"))
+}
+
+func TestSummarize_ShouldGroupByKey(t *testing.T) {
+	grades := New().Grade(loadLines(t), []*cfbd.Game{bowl()})
+	sides := Sides(grades, ConsensusProvider)
+
+	byRole := Summarize(sides, ByRole)
+	byVenue := Summarize(sides, ByVenue)
+
+	require.Len(t, byRole, 2)
+	fav := byRole[0]
+	assert.Equal(t, Favorite, fav.Key)
+	assert.Equal(t, 1, fav.Wins)
+	assert.InDelta(t, 1, fav.CoverPct, 1e-12)
+	assert.InDelta(t, 9.5, fav.MeanCoverMargin, 1e-12)
+	assert.Equal(t, 1, fav.Overs)
+	assert.Equal(t, 1, fav.CLVs)
+	assert.InDelta(t, 1.5, fav.MeanCLV, 1e-12)
+	dog := byRole[1]
+	assert.Equal(t, Underdog, dog.Key)
+	assert.Equal(t, 1, dog.Losses)
+	assert.Zero(t, dog.CoverPct)
+
+	assert.Equal(t, []Summary{{
+		Key: Neutral, Wins: 1, Losses: 1, CoverPct: 0.5, Overs: 2, CLVs: 2,
+	}}, byVenue)
+	assert.Equal(t, NoSpread, ByRole(Side{HasTotal: true}))
+}
+
+func TestSummarizeProviders_ShouldCountEachGameOnce(t *testing.T) {
+	grades := New().Grade(loadLines(t), []*cfbd.Game{bowl()})
+
+	got := SummarizeProviders(grades)
+
+	require.Len(t, got, 3)
+	bovada := got[0]
+	assert.Equal(t, "Bovada", bovada.Key)
+	// Texas was favored and covered.
+	assert.Equal(t, 1, bovada.Wins)
+	assert.Zero(t, bovada.Losses)
+	assert.InDelta(t, 1, bovada.CoverPct, 1e-12)
+	assert.InDelta(t, 7, bovada.MeanCoverMargin, 1e-12)
+	assert.Equal(t, 1, bovada.Overs)
+	assert.Equal(t, 1, bovada.CLVs)
+	assert.InDelta(t, 1.5, bovada.MeanCLV, 1e-12)
+	assert.Equal(t, "Draft Kings", got[1].Key)
+	assert.Zero(t, got[1].CLVs)
+}
+
+func TestSummarizeProviders_PickEm_ShouldOnlyCountTotals(t *testing.T) {
+	lines := loadLines(t)
+	for _, l := range lines[0].GetLines() {
+		l.Spread = new(float64)
+	}
+
+	got := SummarizeProviders(New().Grade(lines, []*cfbd.Game{bowl()}))
+
+	for _, sum := range got {
+		assert.Zero(t, sum.Wins+sum.Losses+sum.Pushes, sum.Key)
+		assert.Zero(t, sum.CLVs, sum.Key)
+		assert.Equal(t, 1, sum.Overs, sum.Key)
+	}
+}
+
+func TestSummarize_CombinedKeys_ShouldSplitFurther(t *testing.T) {
+	grades := New().Grade(loadLines(t), []*cfbd.Game{bowl()})
+	teamRole := func(s Side) string { return ByTeam(s) + "/" + ByRole(s) }
+
+	got := Summarize(Sides(grades, ConsensusProvider), teamRole)
+
+	require.Len(t, got, 2)
+	assert.Equal(t, "Michigan/underdog", got[0].Key)
+	assert.Equal(t, "Texas/favorite", got[1].Key)
+}
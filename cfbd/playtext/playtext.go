@@ -0,0 +1,457 @@
+// Package playtext extracts participants, penalties and outcomes from the
+// free text play descriptions in Play.play_text and LiveGamePlay.play_text.
+//
+// Two styles are understood: the summary style used by GetPlays, e.g.
+// "Arch Manning pass complete to Jack Endries for 16 yds for a TD", and the
+// live feed style, e.g. "(09:27) Shotgun #16 A.Manning pass incomplete short
+// left to #88 J.Endries". Jersey numbers are dropped and "Last, First" names
+// are reordered to "First Last".
+package playtext
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// PenaltyStatus is how a penalty was enforced.
+type PenaltyStatus int
+
+const (
+	// PenaltyAccepted is a penalty that was enforced.
+	PenaltyAccepted PenaltyStatus = iota
+	// PenaltyDeclined is a penalty the other team declined.
+	PenaltyDeclined
+	// PenaltyOffsetting is a penalty offset by one against the other team.
+	PenaltyOffsetting
+)
+
+func (s PenaltyStatus) String() string {
+	switch s {
+	case PenaltyAccepted:
+		return "accepted"
+	case PenaltyDeclined:
+		return "declined"
+	case PenaltyOffsetting:
+		return "offsetting"
+	default:
+		return fmt.Sprintf("PenaltyStatus(%d)", int(s))
+	}
+}
+
+// FieldGoalResult is the outcome of a field goal attempt.
+type FieldGoalResult int
+
+const (
+	// NoFieldGoal means the play was not a field goal attempt.
+	NoFieldGoal FieldGoalResult = iota
+	// FieldGoalGood is a made field goal.
+	FieldGoalGood
+	// FieldGoalMissed is a missed field goal.
+	FieldGoalMissed
+	// FieldGoalBlocked is a blocked field goal.
+	FieldGoalBlocked
+)
+
+func (r FieldGoalResult) String() string {
+	switch r {
+	case NoFieldGoal:
+		return "none"
+	case FieldGoalGood:
+		return "good"
+	case FieldGoalMissed:
+		return "missed"
+	case FieldGoalBlocked:
+		return "blocked"
+	default:
+		return fmt.Sprintf("FieldGoalResult(%d)", int(r))
+	}
+}
+
+// Penalty is a penalty called on a play.
+type Penalty struct {
+	// Type is the foul as written, e.g. "Holding" or "illegal block".
+	Type string
+	// Team is the penalized team as written, usually an abbreviation.
+	Team string
+	// Player is the penalized player, when named.
+	Player string
+	// Yards is the size of the penalty, zero when not stated.
+	Yards  int32
+	Status PenaltyStatus
+}
+
+// ParsedPlay holds what could be read from a play description. Fields the
+// text does not mention are left zero.
+type ParsedPlay struct {
+	Text string
+
+	Passer      string
+	Receiver    string
+	Rusher      string
+	Interceptor string
+	Returner    string
+	// Kicker is the kickoff or field goal kicker.
+	Kicker string
+	Punter string
+	// Tacklers are the players credited with the tackle.
+	Tacklers []string
+	// ExtraPointKicker is set on touchdowns followed by a kicked PAT.
+	ExtraPointKicker string
+
+	Completion   bool
+	Incompletion bool
+	Interception bool
+	Sack         bool
+	// Yards gained on a rush, pass or sack; negative for losses.
+	Yards int32
+	// ReturnYards gained on a kick, punt or interception return.
+	ReturnYards int32
+	// KickDistance is the length of a kickoff, punt or field goal attempt.
+	KickDistance int32
+	FieldGoal    FieldGoalResult
+	Touchback    bool
+	FairCatch    bool
+	Touchdown    bool
+
+	Fumble         bool
+	FumbledBy      string
+	ForcedBy       string
+	RecoveredBy    string
+	RecoveringTeam string
+
+	Penalty *Penalty
+	// NoPlay is set when a penalty wiped out the play.
+	NoPlay bool
+}
+
+// name matches a player, optionally preceded by a jersey number, either as
+// "Last, First" or as capitalized words such as "Kool-Aid McKinstry" or
+// "A.Manning". Words containing digits, such as yard lines, never match.
+const name = `(?:#\d+ )?((?:[\p{Lu}][\p{L}'’.-]*, [\p{Lu}][\p{L}'’.-]*)|` +
+	`(?:[\p{Lu}][\p{L}'’.-]*(?: [\p{Lu}][\p{L}'’.-]*)*))`
+
+// end stops a name at a separator so that it cannot end mid-word.
+const end = `(?:[\s,;()]|$)`
+
+const yds = `(?:[Yy]ds?|[Yy]ards?)`
+
+var (
+	clockPrefix     = regexp.MustCompile(`^\(\d{1,2}:\d{2}\)\s*`)
+	formationPrefix = regexp.MustCompile(
+		`^(?:(?:No Huddle|Shotgun|Under Center|Pistol|Wildcat)[\s-]*)+`)
+
+	passRe = regexp.MustCompile(
+		name + ` pass (complete|incomplete|intercepted)`)
+	passTargetRe = regexp.MustCompile(
+		`^(?: (?:short|deep))?(?: (?:left|middle|right))? to ` + name + end)
+	interceptorRe = regexp.MustCompile(`^(?: by)? ` + name + end)
+	summaryPassRe = regexp.MustCompile(
+		name + ` (\d+) Yd pass from ` + name + end)
+	sackRe = regexp.MustCompile(
+		name + ` sacked(?: by ` + name + `(?: and ` + name + `)?)?` +
+			` for a loss of (\d+) ` + yds)
+	rushRe        = regexp.MustCompile(name + ` (?:rush|run)\b`)
+	summaryRushRe = regexp.MustCompile(name + ` (\d+) Yd Run\b`)
+	gainRe        = regexp.MustCompile(
+		`(?i)for (?:(-?\d+) ` + yds + `(?: (gain|loss))?|(no gain)|` +
+			`a loss of (\d+) ` + yds + `)`)
+
+	kickoffRe = regexp.MustCompile(
+		name + ` kickoff(?: for)? (\d+) ` + yds)
+	puntRe = regexp.MustCompile(
+		name + ` punt(?: for)? (\d+) ` + yds)
+	fieldGoalRe = regexp.MustCompile(
+		name + ` (\d+) ` + yds + ` (?i:field goal|fg)` +
+			`(?: (?i:(good|missed|no good|blocked)))?`)
+	returnRe = regexp.MustCompile(
+		name + ` returns?(?: for)? (-?\d+) ` + yds)
+	extraPointRe = regexp.MustCompile(`\(` + name + ` (?i:kick)\)`)
+
+	tackledByRe = regexp.MustCompile(`tackled by ` + name + end)
+	parenRe     = regexp.MustCompile(`\(([^()]+)\)`)
+	tacklerRe   = regexp.MustCompile(`^` + name + `$`)
+	// tacklerStartRe also reads the recovering player after a team.
+	tacklerStartRe = regexp.MustCompile(`^` + name + end)
+	jerseyRe       = regexp.MustCompile(`#\d+ `)
+	notTackler     = regexp.MustCompile(`(?i)\b(?:kick|pat|run|pass|failed|` +
+		`good|missed|blocked|yards?|yds?)\b|\d`)
+
+	touchdownRe = regexp.MustCompile(`(?i)\b(?:td|touchdown)\b`)
+	fumbleRe    = regexp.MustCompile(`(?i)\bfumbled?\b`)
+	fumbledByRe = regexp.MustCompile(`fumbled by ` + name + end)
+	fumblerRe   = regexp.MustCompile(name + `,? fumbled?\b`)
+	forcedByRe  = regexp.MustCompile(`forced by ` + name + end)
+	recoveredRe = regexp.MustCompile(`recovered by ([^\s,]+) `)
+
+	penaltyIndexRe   = regexp.MustCompile(`(?i)\bpenalty\b`)
+	summaryPenaltyRe = regexp.MustCompile(
+		`^(\p{L}[\p{L}&.' -]*?) Penalty, ([^(,]+?)` +
+			`(?: \((-?\d+) Yards?\)(?: \(` + name + `\))?|,|$)`)
+	livePenaltyRe = regexp.MustCompile(
+		`^(?i:penalty),? (?:on )?([\p{L}&]+)(?:-` + name + `)?[ ,]+` +
+			`([^(,0-9]+)(?:\(([^()]+)\))?(?:,? ?(\d+) (?i:` + yds + `))?`)
+	// penaltyStatusSuffix trims the status from a penalty type written as
+	// "Holding declined".
+	penaltyStatusSuffix = regexp.MustCompile(
+		`(?i)[\s.]+(?:accepted|declined|offsetting|no play)?[\s.]*$`)
+	noPlayRe = regexp.MustCompile(`(?i)\bno play\b`)
+)
+
+// Parse extracts what it can from a play description.
+func Parse(text string) ParsedPlay {
+	p := ParsedPlay{Text: text}
+	s := strings.TrimSpace(text)
+	s = clockPrefix.ReplaceAllString(s, "")
+	s = formationPrefix.ReplaceAllString(s, "")
+
+	// Penalties are read from their own segment and cut off so that the
+	// penalized player is not taken for a tackler.
+	play := s
+	if loc := penaltyIndexRe.FindStringIndex(s); loc != nil {
+		p.Penalty = parsePenalty(s, loc[0])
+		play = s[:loc[0]]
+		p.NoPlay = noPlayRe.MatchString(s)
+	}
+
+	p.parseScrimmage(play)
+	p.parseKicks(play)
+	p.parseFumble(play)
+	p.parseTacklers(play)
+	p.Touchdown = p.Touchdown || touchdownRe.MatchString(play)
+
+	return p
+}
+
+// ParsePlay parses the description of a play from GetPlays.
+func ParsePlay(play *cfbd.Play) ParsedPlay {
+	return Parse(play.GetPlayText())
+}
+
+// ParseLivePlay parses the description of a play from GetLivePlays.
+func ParseLivePlay(play *cfbd.LiveGamePlay) ParsedPlay {
+	return Parse(play.GetPlayText())
+}
+
+func (p *ParsedPlay) parseScrimmage(s string) {
+	if m := summaryPassRe.FindStringSubmatch(s); m != nil {
+		p.Receiver, p.Passer = cleanName(m[1]), cleanName(m[3])
+		p.Yards = atoi(m[2])
+		p.Completion, p.Touchdown = true, true
+		return
+	}
+	if m := summaryRushRe.FindStringSubmatch(s); m != nil {
+		p.Rusher, p.Yards, p.Touchdown = cleanName(m[1]), atoi(m[2]), true
+		return
+	}
+
+	if loc := passRe.FindStringSubmatchIndex(s); loc != nil {
+		p.Passer = cleanName(s[loc[2]:loc[3]])
+		rest := s[loc[1]:]
+		switch s[loc[4]:loc[5]] {
+		case "complete":
+			p.Completion = true
+			p.Receiver = firstName(passTargetRe, rest)
+			p.Yards = gain(rest)
+		case "incomplete":
+			p.Incompletion = true
+			p.Receiver = firstName(passTargetRe, rest)
+		case "intercepted":
+			p.Interception = true
+			p.Interceptor = firstName(interceptorRe, rest)
+		}
+		return
+	}
+
+	if m := sackRe.FindStringSubmatch(s); m != nil {
+		p.Passer, p.Sack = cleanName(m[1]), true
+		for _, tackler := range m[2:4] {
+			if tackler != "" {
+				p.Tacklers = append(p.Tacklers, cleanName(tackler))
+			}
+		}
+		p.Yards = -atoi(m[4])
+		return
+	}
+
+	if loc := rushRe.FindStringSubmatchIndex(s); loc != nil {
+		p.Rusher = cleanName(s[loc[2]:loc[3]])
+		p.Yards = gain(s[loc[1]:])
+	}
+}
+
+func (p *ParsedPlay) parseKicks(s string) {
+	if m := kickoffRe.FindStringSubmatch(s); m != nil {
+		p.Kicker, p.KickDistance = cleanName(m[1]), atoi(m[2])
+	}
+	if m := puntRe.FindStringSubmatch(s); m != nil {
+		p.Punter, p.KickDistance = cleanName(m[1]), atoi(m[2])
+	}
+	if m := fieldGoalRe.FindStringSubmatch(s); m != nil {
+		p.Kicker, p.KickDistance = cleanName(m[1]), atoi(m[2])
+		switch strings.ToLower(m[3]) {
+		case "missed", "no good":
+			p.FieldGoal = FieldGoalMissed
+		case "blocked":
+			p.FieldGoal = FieldGoalBlocked
+		default:
+			p.FieldGoal = FieldGoalGood
+		}
+	}
+	if m := returnRe.FindStringSubmatch(s); m != nil {
+		p.Returner, p.ReturnYards = cleanName(m[1]), atoi(m[2])
+	}
+	if m := extraPointRe.FindStringSubmatch(s); m != nil {
+		p.ExtraPointKicker = cleanName(m[1])
+	}
+
+	lower := strings.ToLower(s)
+	p.Touchback = strings.Contains(lower, "touchback")
+	p.FairCatch = strings.Contains(lower, "fair catch")
+}
+
+func (p *ParsedPlay) parseFumble(s string) {
+	if !fumbleRe.MatchString(s) {
+		return
+	}
+	p.Fumble = true
+
+	p.FumbledBy = firstName(fumbledByRe, s)
+	if p.FumbledBy == "" {
+		p.FumbledBy = firstName(fumblerRe, s)
+	}
+	p.ForcedBy = firstName(forcedByRe, s)
+
+	// The recovering team may precede the player, as in "recovered by
+	// Mich #7 T.Metcalf" or "recovered by ALA Jalen Smith".
+	loc := recoveredRe.FindStringSubmatchIndex(s)
+	if loc == nil {
+		return
+	}
+	first, rest := s[loc[2]:loc[3]], s[loc[1]:]
+	if strings.HasPrefix(rest, "#") || isAbbreviation(first) {
+		p.RecoveringTeam = first
+		p.RecoveredBy = firstName(tacklerStartRe, rest)
+		return
+	}
+	p.RecoveredBy = firstName(tacklerStartRe, s[loc[2]:])
+}
+
+func (p *ParsedPlay) parseTacklers(s string) {
+	if p.Sack {
+		return
+	}
+	if m := tackledByRe.FindStringSubmatch(s); m != nil {
+		p.Tacklers = append(p.Tacklers, cleanName(m[1]))
+		return
+	}
+
+	for _, m := range parenRe.FindAllStringSubmatch(s, -1) {
+		if notTackler.MatchString(jerseyRe.ReplaceAllString(m[1], "")) {
+			continue
+		}
+		for part := range strings.SplitSeq(m[1], ";") {
+			for part := range strings.SplitSeq(part, " and ") {
+				if tm := tacklerRe.FindStringSubmatch(
+					strings.TrimSpace(part)); tm != nil {
+					p.Tacklers = append(p.Tacklers, cleanName(tm[1]))
+				}
+			}
+		}
+	}
+}
+
+func parsePenalty(s string, at int) *Penalty {
+	if m := summaryPenaltyRe.FindStringSubmatch(s); m != nil {
+		pen := &Penalty{
+			Team:   strings.TrimSpace(m[1]),
+			Type:   strings.TrimSpace(m[2]),
+			Player: cleanName(m[4]),
+			Status: penaltyStatus(s),
+		}
+		pen.Yards = abs(atoi(m[3]))
+		return pen
+	}
+
+	seg := s[at:]
+	pen := &Penalty{Status: penaltyStatus(seg)}
+	if m := livePenaltyRe.FindStringSubmatch(seg); m != nil {
+		pen.Team = m[1]
+		pen.Player = cleanName(m[2])
+		pen.Type = penaltyStatusSuffix.ReplaceAllString(m[3], "")
+		if m[4] != "" && pen.Player == "" {
+			pen.Player = cleanName(m[4])
+		}
+		pen.Yards = atoi(m[5])
+	}
+
+	return pen
+}
+
+func penaltyStatus(s string) PenaltyStatus {
+	lower := strings.ToLower(s)
+	switch {
+	case strings.Contains(lower, "declined"):
+		return PenaltyDeclined
+	case strings.Contains(lower, "offset"):
+		return PenaltyOffsetting
+	}
+
+	return PenaltyAccepted
+}
+
+// gain reads the yards gained from the text following a pass or rush.
+func gain(s string) int32 {
+	m := gainRe.FindStringSubmatch(s)
+	switch {
+	case m == nil, m[3] != "":
+		return 0
+	case m[4] != "":
+		return -atoi(m[4])
+	case strings.EqualFold(m[2], "loss"):
+		return -abs(atoi(m[1]))
+	}
+
+	return atoi(m[1])
+}
+
+func firstName(re *regexp.Regexp, s string) string {
+	if m := re.FindStringSubmatch(s); m != nil {
+		return cleanName(m[1])
+	}
+
+	return ""
+}
+
+// cleanName reorders "Last, First" to "First Last".
+func cleanName(s string) string {
+	s = strings.TrimSpace(s)
+	if last, first, ok := strings.Cut(s, ", "); ok {
+		return first + " " + last
+	}
+
+	return s
+}
+
+// isAbbreviation reports whether s looks like a team abbreviation such as
+// "ALA" or "TEX".
+func isAbbreviation(s string) bool {
+	return len(s) >= 2 && strings.ToUpper(s) == s &&
+		!strings.ContainsAny(s, ".#")
+}
+
+func atoi(s string) int32 {
+	n, _ := strconv.ParseInt(s, 10, 32)
+	return int32(n)
+}
+
+func abs(n int32) int32 {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
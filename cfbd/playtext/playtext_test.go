@@ -0,0 +1,257 @@
+package playtext
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func loadPlays(t *testing.T) []*cfbd.Play {
+	return fixture.Load(t, "plays.json",
+		func() *cfbd.Play { return &cfbd.Play{} })
+}
+
+func loadLivePlays(t *testing.T) []*cfbd.LiveGamePlay {
+	var game cfbd.LiveGame
+	require.NoError(t, protojson.UnmarshalOptions{DiscardUnknown: true}.
+		Unmarshal(fixture.Read(t, "live_plays.json"), &game))
+
+	var plays []*cfbd.LiveGamePlay
+	for _, d := range game.GetDrives() {
+		plays = append(plays, d.GetPlays()...)
+	}
+
+	return plays
+}
+
+// checkAgainstPlayType asserts the parsed text agrees with the structured
+// play type the API assigned to it.
+func checkAgainstPlayType(
+	t *testing.T, playType string, yards int32, p ParsedPlay,
+) {
+	t.Helper()
+	switch {
+	case playType == "Penalty":
+		require.NotNil(t, p.Penalty, p.Text)
+		assert.NotEmpty(t, p.Penalty.Type, p.Text)
+		assert.NotEmpty(t, p.Penalty.Team, p.Text)
+	case strings.Contains(playType, "Pass"):
+		assert.NotEmpty(t, p.Passer, p.Text)
+		assert.NotEmpty(t, p.Receiver, p.Text)
+		assert.Equal(t, yards, p.Yards, p.Text)
+	case playType == "Rush" || strings.Contains(playType, "Rushing"):
+		assert.NotEmpty(t, p.Rusher, p.Text)
+		assert.Equal(t, yards, p.Yards, p.Text)
+	case strings.Contains(playType, "Kickoff"):
+		assert.NotEmpty(t, p.Kicker, p.Text)
+		assert.Positive(t, p.KickDistance, p.Text)
+	case strings.Contains(playType, "Fumble"):
+		assert.True(t, p.Fumble, p.Text)
+		assert.NotEmpty(t, p.RecoveredBy, p.Text)
+	}
+	assert.Equal(t, strings.Contains(playType, "Touchdown"), p.Touchdown,
+		p.Text)
+}
+
+func TestParsePlay_PlaysCorpus_ShouldAgreeWithPlayType(t *testing.T) {
+	plays := loadPlays(t)
+	require.NotEmpty(t, plays)
+
+	for _, play := range plays {
+		checkAgainstPlayType(t, play.GetPlayType(), play.GetYardsGained(),
+			ParsePlay(play))
+	}
+}
+
+func TestParseLivePlay_LivePlaysCorpus_ShouldAgreeWithPlayType(t *testing.T) {
+	plays := loadLivePlays(t)
+	require.NotEmpty(t, plays)
+
+	for _, play := range plays {
+		checkAgainstPlayType(t, play.GetPlayType(), play.GetYardsGained(),
+			ParseLivePlay(play))
+	}
+}
+
+func TestParse_PlaysFixture_ShouldExtractEveryField(t *testing.T) {
+	plays := loadPlays(t)
+	require.Len(t, plays, 2)
+
+	penalty := ParsePlay(plays[0])
+	assert.Equal(t, "CJ Baxter", penalty.Rusher)
+	assert.Equal(t, int32(1), penalty.Yards)
+	assert.True(t, penalty.NoPlay)
+	assert.Empty(t, penalty.Tacklers)
+	assert.Equal(t, &Penalty{
+		Type:   "illegal block",
+		Team:   "TEX",
+		Player: "Cole Hutson",
+		Yards:  15,
+		Status: PenaltyAccepted,
+	}, penalty.Penalty)
+
+	touchdown := ParsePlay(plays[1])
+	assert.Equal(t, "Arch Manning", touchdown.Passer)
+	assert.Equal(t, "Jack Endries", touchdown.Receiver)
+	assert.True(t, touchdown.Completion)
+	assert.True(t, touchdown.Touchdown)
+	assert.Equal(t, int32(16), touchdown.Yards)
+	assert.Equal(t, "Mason Shipley", touchdown.ExtraPointKicker)
+	assert.Empty(t, touchdown.Tacklers)
+}
+
+func TestParse_LivePlaysFixture_ShouldExtractEveryField(t *testing.T) {
+	byID := make(map[string]ParsedPlay)
+	for _, play := range loadLivePlays(t) {
+		byID[play.GetId()] = ParseLivePlay(play)
+	}
+
+	touchback := byID["4017783303"]
+	assert.Equal(t, "B.Sunderland", touchback.Kicker)
+	assert.Equal(t, int32(65), touchback.KickDistance)
+	assert.True(t, touchback.Touchback)
+
+	var ret, incomplete, rush, fumble ParsedPlay
+	for _, p := range byID {
+		switch {
+		case p.Returner == "A.Marsh":
+			ret = p
+		case p.Incompletion:
+			incomplete = p
+		case p.Rusher != "":
+			rush = p
+		case p.Fumble:
+			fumble = p
+		}
+	}
+
+	assert.Equal(t, "S.Barnett", ret.Kicker)
+	assert.Equal(t, int32(57), ret.KickDistance)
+	assert.Equal(t, int32(18), ret.ReturnYards)
+	assert.Equal(t, []string{"M.Landwehr", "R.Niblett"}, ret.Tacklers)
+
+	assert.Equal(t, "A.Manning", incomplete.Passer)
+	assert.Equal(t, "J.Endries", incomplete.Receiver)
+
+	assert.Equal(t, "B.Kuzdzal", rush.Rusher)
+	assert.Equal(t, int32(8), rush.Yards)
+	assert.Equal(t, []string{"T.Smith"}, rush.Tacklers)
+
+	assert.Equal(t, "R.Niblett", fumble.Returner)
+	assert.Equal(t, "R.Niblett", fumble.FumbledBy)
+	assert.Equal(t, "C.Sullivan", fumble.ForcedBy)
+	assert.Equal(t, "Mich", fumble.RecoveringTeam)
+	assert.Equal(t, "T.Metcalf", fumble.RecoveredBy)
+}
+
+func TestParse_SummaryStyles_ShouldExtract(t *testing.T) {
+	for _, tc := range []struct {
+		text string
+		want ParsedPlay
+	}{
+		{
+			text: "Jalen Milroe run for 5 yds to the ALA 30 " +
+				"(Dallas Turner)",
+			want: ParsedPlay{Rusher: "Jalen Milroe", Yards: 5,
+				Tacklers: []string{"Dallas Turner"}},
+		},
+		{
+			text: "Jalen Milroe run for a loss of 3 yards to the ALA 22",
+			want: ParsedPlay{Rusher: "Jalen Milroe", Yards: -3},
+		},
+		{
+			text: "Jalen Milroe sacked by Dallas Turner and Will Anderson " +
+				"for a loss of 7 yards to the ALA 18",
+			want: ParsedPlay{Passer: "Jalen Milroe", Sack: true, Yards: -7,
+				Tacklers: []string{"Dallas Turner", "Will Anderson"}},
+		},
+		{
+			text: "Jalen Milroe pass intercepted Kool-Aid McKinstry " +
+				"return for 25 yds to the ALA 40",
+			want: ParsedPlay{Passer: "Jalen Milroe", Interception: true,
+				Interceptor: "Kool-Aid McKinstry",
+				Returner:    "Kool-Aid McKinstry", ReturnYards: 25},
+		},
+		{
+			text: "Jase McClellan 2 Yd Run (Will Reichard Kick)",
+			want: ParsedPlay{Rusher: "Jase McClellan", Yards: 2,
+				Touchdown: true, ExtraPointKicker: "Will Reichard"},
+		},
+		{
+			text: "Isaiah Bond 34 Yd pass from Jalen Milroe " +
+				"(Will Reichard Kick)",
+			want: ParsedPlay{Receiver: "Isaiah Bond", Passer: "Jalen Milroe",
+				Completion: true, Yards: 34, Touchdown: true,
+				ExtraPointKicker: "Will Reichard"},
+		},
+		{
+			text: "Will Reichard 45 yd FG MISSED",
+			want: ParsedPlay{Kicker: "Will Reichard", KickDistance: 45,
+				FieldGoal: FieldGoalMissed},
+		},
+		{
+			text: "Will Reichard 38 Yd Field Goal",
+			want: ParsedPlay{Kicker: "Will Reichard", KickDistance: 38,
+				FieldGoal: FieldGoalGood},
+		},
+		{
+			text: "James Burnip punt for 44 yds, fair catch by " +
+				"Jalen Hale at the TEX 16",
+			want: ParsedPlay{Punter: "James Burnip", KickDistance: 44,
+				FairCatch: true},
+		},
+		{
+			text: "Quinn Ewers pass complete to Xavier Worthy for no gain " +
+				"to the TEX 35, Xavier Worthy fumbled, recovered by ALA " +
+				"Jihaad Campbell",
+			want: ParsedPlay{Passer: "Quinn Ewers", Receiver: "Xavier Worthy",
+				Completion: true, Fumble: true, FumbledBy: "Xavier Worthy",
+				RecoveringTeam: "ALA", RecoveredBy: "Jihaad Campbell"},
+		},
+	} {
+		got := Parse(tc.text)
+		tc.want.Text = tc.text
+		assert.Equal(t, tc.want, got, tc.text)
+	}
+}
+
+func TestParse_Penalties_ShouldReadTeamTypeAndStatus(t *testing.T) {
+	for _, tc := range []struct {
+		text string
+		want Penalty
+	}{
+		{
+			text: "Texas Penalty, False Start (-5 Yards) to the TEX 20",
+			want: Penalty{Type: "False Start", Team: "Texas", Yards: 5},
+		},
+		{
+			text: "Quinn Ewers pass complete to Adonai Mitchell for 12 yds " +
+				"to the ALA 40 PENALTY ALA Holding declined",
+			want: Penalty{Type: "Holding", Team: "ALA",
+				Status: PenaltyDeclined},
+		},
+		{
+			text: "Penalty on TEX, Unsportsmanlike Conduct, offsetting",
+			want: Penalty{Type: "Unsportsmanlike Conduct", Team: "TEX",
+				Status: PenaltyOffsetting},
+		},
+	} {
+		got := Parse(tc.text)
+		require.NotNil(t, got.Penalty, tc.text)
+		assert.Equal(t, tc.want, *got.Penalty, tc.text)
+	}
+}
+
+func TestParse_Empty_ShouldReturnZeroFields(t *testing.T) {
+	assert.Equal(t, ParsedPlay{}, Parse(""))
+}
+
+func TestPenaltyStatus_String_ShouldNameStatus(t *testing.T) {
+	assert.Equal(t, "declined", PenaltyDeclined.String())
+	assert.Equal(t, "PenaltyStatus(9)", PenaltyStatus(9).String())
+}
@@ -0,0 +1,244 @@
+package sim
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+type teamInfo struct {
+	name       string
+	conference string
+	fbs        bool
+	played     int
+	ties       int
+}
+
+// game is a regular season game between team indexes. p is the home win
+// probability of a game not yet completed.
+type game struct {
+	home, away int
+	p          float64
+	completed  bool
+	margin     int32
+	conference bool
+}
+
+// conference holds the FBS members of a conference and the neutral site
+// odds between them, used for title games.
+type conference struct {
+	name     string
+	members  []int
+	hasTitle bool
+	// title is the conference's title game when it was among the games.
+	title *titleGame
+	// odds[i][j] is the probability that members[i] beats members[j] at a
+	// neutral site.
+	odds [][]float64
+	pos  map[int]int
+}
+
+// titleGame is a scheduled title game between team indexes a and b. p is
+// the probability that a wins, or 1 or 0 once the game is completed.
+type titleGame struct {
+	g    *cfbd.Game
+	a, b int
+	p    float64
+}
+
+func (s *Simulator) team(name, conference, classification string) {
+	if i, ok := s.index[name]; ok {
+		if s.teams[i].conference == "" {
+			s.teams[i].conference = conference
+		}
+		return
+	}
+
+	s.index[name] = len(s.teams)
+	s.teams = append(s.teams, teamInfo{
+		name:       name,
+		conference: conference,
+		fbs:        classification == classificationFBS,
+	})
+}
+
+// buildConferences groups FBS teams by conference and returns the games
+// that are conference title games.
+func (s *Simulator) buildConferences(
+	games []*cfbd.Game,
+) map[*cfbd.Game]bool {
+	byName := make(map[string]*conference)
+	for i, t := range s.teams {
+		if !t.fbs || t.conference == "" {
+			continue
+		}
+		s.fbs = append(s.fbs, i)
+		c, ok := byName[t.conference]
+		if !ok {
+			c = &conference{name: t.conference, pos: make(map[int]int)}
+			byName[t.conference] = c
+		}
+		c.pos[i] = len(c.members)
+		c.members = append(c.members, i)
+	}
+	for _, name := range slices.Sorted(maps.Keys(byName)) {
+		c := byName[name]
+		c.hasTitle = s.hasTitleGame(c)
+		s.conferences = append(s.conferences, c)
+	}
+
+	titles := make(map[*cfbd.Game]bool)
+	for _, g := range games {
+		c := s.titleGameConference(g, byName)
+		if c == nil {
+			continue
+		}
+		c.title = &titleGame{
+			g: g,
+			a: s.index[g.GetHomeTeam()],
+			b: s.index[g.GetAwayTeam()],
+		}
+		titles[g] = true
+	}
+
+	return titles
+}
+
+func (s *Simulator) hasTitleGame(c *conference) bool {
+	if s.titleConferences != nil {
+		return slices.Contains(s.titleConferences, c.name)
+	}
+
+	return c.name != Independents && len(c.members) >= 2
+}
+
+// titleGameConference returns the conference g is the title game of, or
+// nil when it is a regular season game.
+func (s *Simulator) titleGameConference(
+	g *cfbd.Game, byName map[string]*conference,
+) *conference {
+	if !strings.Contains(strings.ToLower(g.GetNotes()), "championship") {
+		return nil
+	}
+
+	home := s.teams[s.index[g.GetHomeTeam()]]
+	away := s.teams[s.index[g.GetAwayTeam()]]
+	c, ok := byName[home.conference]
+	if !ok || !c.hasTitle || !away.fbs || away.conference != c.name {
+		return nil
+	}
+
+	return c
+}
+
+func (s *Simulator) newGame(g *cfbd.Game, model Model) game {
+	home, away := s.index[g.GetHomeTeam()], s.index[g.GetAwayTeam()]
+	out := game{
+		home:      home,
+		away:      away,
+		completed: completed(g),
+		margin:    g.GetHomePoints() - g.GetAwayPoints(),
+		conference: s.teams[home].fbs && s.teams[away].fbs &&
+			s.teams[home].conference != "" &&
+			s.teams[home].conference == s.teams[away].conference,
+	}
+	s.teams[home].played++
+	s.teams[away].played++
+
+	switch p, ok := model.HomeWinProbability(g); {
+	case out.completed:
+		if out.margin == 0 {
+			s.teams[home].ties++
+			s.teams[away].ties++
+		}
+	case ok:
+		out.p = p
+	default:
+		out.p = 0.5
+		s.unmodeled = append(s.unmodeled, g.GetId())
+	}
+
+	return out
+}
+
+func completed(g *cfbd.Game) bool {
+	return g.GetCompleted() && g.HomePoints != nil && g.AwayPoints != nil
+}
+
+// buildConferenceOdds asks the model for every neutral site pairing of
+// title game conference members.
+func (s *Simulator) buildConferenceOdds(model Model) {
+	for _, c := range s.conferences {
+		if !c.hasTitle {
+			continue
+		}
+		c.odds = make([][]float64, len(c.members))
+		for i, a := range c.members {
+			c.odds[i] = make([]float64, len(c.members))
+			for j, b := range c.members {
+				c.odds[i][j] = s.neutralOdds(model, a, b)
+			}
+		}
+		if c.title != nil {
+			c.title.p = s.titleOdds(model, c)
+		}
+	}
+}
+
+func (s *Simulator) neutralOdds(model Model, a, b int) float64 {
+	p, ok := model.HomeWinProbability(&cfbd.Game{
+		HomeTeam:       s.teams[a].name,
+		HomeConference: s.teams[a].conference,
+		AwayTeam:       s.teams[b].name,
+		AwayConference: s.teams[b].conference,
+		NeutralSite:    true,
+	})
+	if !ok {
+		return 0.5
+	}
+
+	return p
+}
+
+func (s *Simulator) titleOdds(model Model, c *conference) float64 {
+	t := c.title
+	if completed(t.g) {
+		if t.g.GetHomePoints() > t.g.GetAwayPoints() {
+			return 1
+		}
+		return 0
+	}
+	if p, ok := model.HomeWinProbability(t.g); ok {
+		return p
+	}
+
+	return c.odds[c.pos[t.a]][c.pos[t.b]]
+}
+
+// buildPower sets the tiebreaking strength of every team.
+func (s *Simulator) buildPower() {
+	s.power = make([]float64, len(s.teams))
+	if s.powerFunc != nil {
+		for i, t := range s.teams {
+			s.power[i] = s.powerFunc(t.name)
+		}
+		return
+	}
+
+	for _, g := range s.games {
+		p := g.p
+		if g.completed {
+			p = 0.5
+			switch {
+			case g.margin > 0:
+				p = 1
+			case g.margin < 0:
+				p = 0
+			}
+		}
+		s.power[g.home] += p
+		s.power[g.away] += 1 - p
+	}
+}
@@ -0,0 +1,98 @@
+package sim
+
+import (
+	"math"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/strength"
+)
+
+// Model gives the probability that the home team wins a game. ok is false
+// when the model has nothing to say about the game.
+type Model interface {
+	HomeWinProbability(g *cfbd.Game) (float64, bool)
+}
+
+// ModelFunc adapts a function to a Model.
+type ModelFunc func(g *cfbd.Game) (float64, bool)
+
+// HomeWinProbability calls f.
+func (f ModelFunc) HomeWinProbability(g *cfbd.Game) (float64, bool) {
+	return f(g)
+}
+
+// Chain asks each model in turn and returns the first answer.
+func Chain(models ...Model) Model {
+	return ModelFunc(func(g *cfbd.Game) (float64, bool) {
+		for _, m := range models {
+			if p, ok := m.HomeWinProbability(g); ok {
+				return p, true
+			}
+		}
+		return 0, false
+	})
+}
+
+// FromPregame answers with GetPregameWinProbability results, matched by
+// game ID.
+func FromPregame(wps []*cfbd.PregameWinProbability) Model {
+	byID := make(map[int32]float64, len(wps))
+	for _, wp := range wps {
+		byID[wp.GetGameId()] = wp.GetHomeWinProbability()
+	}
+
+	return ModelFunc(func(g *cfbd.Game) (float64, bool) {
+		p, ok := byID[g.GetId()]
+		return p, ok
+	})
+}
+
+// FromLines answers with the average spread across providers from
+// GetBettingLines, matched by game ID. Margins are taken to be normally
+// distributed around the spread with standard deviation sigma points.
+func FromLines(lines []*cfbd.BettingGame, sigma float64) Model {
+	spreads := make(map[int32]float64, len(lines))
+	for _, bg := range lines {
+		var sum float64
+		var n int
+		for _, l := range bg.GetLines() {
+			if l.Spread != nil {
+				sum += l.GetSpread()
+				n++
+			}
+		}
+		if n > 0 {
+			spreads[bg.GetId()] = sum / float64(n)
+		}
+	}
+
+	return ModelFunc(func(g *cfbd.Game) (float64, bool) {
+		spread, ok := spreads[g.GetId()]
+		if !ok {
+			return 0, false
+		}
+		// A negative spread favors the home team.
+		return 0.5 * math.Erfc(spread/(sigma*math.Sqrt2)), true
+	})
+}
+
+// FromRater answers with a rating model, adding homeField, in the rater's
+// units, to the home team outside neutral site games. Games with an
+// unrated team are left unanswered.
+func FromRater(r strength.Rater, homeField float64) Model {
+	return ModelFunc(func(g *cfbd.Game) (float64, bool) {
+		home, ok := r.Rating(g.GetHomeTeam())
+		if !ok {
+			return 0, false
+		}
+		away, ok := r.Rating(g.GetAwayTeam())
+		if !ok {
+			return 0, false
+		}
+		diff := home - away
+		if !g.GetNeutralSite() {
+			diff += homeField
+		}
+		return r.WinProbability(diff), true
+	})
+}
@@ -0,0 +1,68 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/clintrovert/cfbd-go/cfbd/strength"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromPregame_Fixture_ShouldMatchByGameID(t *testing.T) {
+	m := FromPregame(fixture.Load(t, "metrics_wp_pregame.json",
+		func() *cfbd.PregameWinProbability {
+			return &cfbd.PregameWinProbability{}
+		}))
+
+	p, ok := m.HomeWinProbability(&cfbd.Game{Id: 401769072})
+	require.True(t, ok)
+	assert.InDelta(t, 0.698, p, 1e-9)
+
+	_, ok = m.HomeWinProbability(&cfbd.Game{Id: 1})
+	assert.False(t, ok)
+}
+
+func TestFromLines_Fixture_ShouldAverageProviderSpreads(t *testing.T) {
+	m := FromLines(fixture.Load(t, "lines.json", func() *cfbd.BettingGame {
+		return &cfbd.BettingGame{}
+	}), strength.DefaultSigma)
+
+	p, ok := m.HomeWinProbability(&cfbd.Game{Id: 401778330})
+
+	require.True(t, ok)
+	// The three providers average Texas -5.17.
+	assert.InDelta(t, 0.6266, p, 1e-4)
+}
+
+func TestFromRater_ShouldAddHomeFieldOffNeutralSites(t *testing.T) {
+	rater := strength.NewPoints(map[string]float64{"A": 3, "B": 0},
+		strength.DefaultSigma)
+	m := FromRater(rater, 3)
+
+	home, ok := m.HomeWinProbability(&cfbd.Game{HomeTeam: "B",
+		AwayTeam: "A"})
+	require.True(t, ok)
+	assert.InDelta(t, 0.5, home, 1e-9)
+
+	neutral, _ := m.HomeWinProbability(&cfbd.Game{HomeTeam: "B",
+		AwayTeam: "A", NeutralSite: true})
+	assert.Less(t, neutral, 0.5)
+
+	_, ok = m.HomeWinProbability(&cfbd.Game{HomeTeam: "B",
+		AwayTeam: "Montana"})
+	assert.False(t, ok)
+}
+
+func TestChain_ShouldUseFirstAnswer(t *testing.T) {
+	never := ModelFunc(func(*cfbd.Game) (float64, bool) { return 0, false })
+	always := ModelFunc(func(*cfbd.Game) (float64, bool) { return 0.7, true })
+
+	p, ok := Chain(never, always).HomeWinProbability(&cfbd.Game{})
+	require.True(t, ok)
+	assert.InDelta(t, 0.7, p, 1e-9)
+
+	_, ok = Chain(never).HomeWinProbability(&cfbd.Game{})
+	assert.False(t, ok)
+}
@@ -0,0 +1,328 @@
+package sim
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync"
+)
+
+// state is the outcome of one simulated season.
+type state struct {
+	wins       []int
+	fcsWins    []int
+	confWins   []int
+	confLosses []int
+	champion   []bool
+	order      []int
+	standings  []int
+	field      []int
+}
+
+// counts accumulates outcomes over the runs of a chunk.
+type counts struct {
+	records  [][]int64
+	title    []int64
+	champion []int64
+	bowl     []int64
+	playoff  []int64
+	seeds    [][]int64
+}
+
+// Run simulates the season runs times. Runs are split into fixed chunks,
+// each drawn from its own stream of the seed, and spread over the workers,
+// so the result depends only on the seed.
+func (s *Simulator) Run(ctx context.Context, runs int) (Result, error) {
+	if runs <= 0 {
+		return Result{}, ErrInvalidRuns
+	}
+
+	chunks := (runs + chunkSize - 1) / chunkSize
+	results := make([]*counts, chunks)
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range max(s.workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range jobs {
+				results[c] = s.chunk(c, min(chunkSize, runs-c*chunkSize))
+			}
+		}()
+	}
+
+	var err error
+	for c := range chunks {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		jobs <- c
+	}
+	close(jobs)
+	wg.Wait()
+	if err != nil {
+		return Result{}, fmt.Errorf("simulation canceled; %w", err)
+	}
+
+	total := s.newCounts()
+	for _, c := range results {
+		total.add(c)
+	}
+
+	return s.result(runs, total), nil
+}
+
+func (s *Simulator) chunk(c, runs int) *counts {
+	rng := rand.New(rand.NewPCG(s.seed, uint64(c)))
+	st := s.newState()
+	cnt := s.newCounts()
+	for range runs {
+		s.once(rng, st, cnt)
+	}
+
+	return cnt
+}
+
+func (s *Simulator) newState() *state {
+	n := len(s.teams)
+
+	return &state{
+		wins:       make([]int, n),
+		fcsWins:    make([]int, n),
+		confWins:   make([]int, n),
+		confLosses: make([]int, n),
+		champion:   make([]bool, n),
+	}
+}
+
+func (s *Simulator) newCounts() *counts {
+	n := len(s.teams)
+	c := &counts{
+		records:  make([][]int64, n),
+		title:    make([]int64, n),
+		champion: make([]int64, n),
+		bowl:     make([]int64, n),
+		playoff:  make([]int64, n),
+		seeds:    make([][]int64, n),
+	}
+	for i, t := range s.teams {
+		c.records[i] = make([]int64, t.played+1)
+		c.seeds[i] = make([]int64, s.playoffSize)
+	}
+
+	return c
+}
+
+func (c *counts) add(o *counts) {
+	for i := range c.records {
+		addInto(c.records[i], o.records[i])
+		addInto(c.seeds[i], o.seeds[i])
+	}
+	addInto(c.title, o.title)
+	addInto(c.champion, o.champion)
+	addInto(c.bowl, o.bowl)
+	addInto(c.playoff, o.playoff)
+}
+
+func addInto(dst, src []int64) {
+	for i, v := range src {
+		dst[i] += v
+	}
+}
+
+// once simulates one season.
+func (s *Simulator) once(rng *rand.Rand, st *state, cnt *counts) {
+	clear(st.wins)
+	clear(st.fcsWins)
+	clear(st.confWins)
+	clear(st.confLosses)
+	clear(st.champion)
+
+	for i := range s.games {
+		g := &s.games[i]
+		margin := g.margin
+		if !g.completed {
+			margin = -1
+			if rng.Float64() < g.p {
+				margin = 1
+			}
+		}
+		s.apply(st, g, margin)
+	}
+
+	for i, t := range s.teams {
+		cnt.records[i][st.wins[i]]++
+		counted := st.wins[i] - st.fcsWins[i] + min(st.fcsWins[i], 1)
+		if t.fbs && counted >= s.bowlWins {
+			cnt.bowl[i]++
+		}
+	}
+	for _, c := range s.conferences {
+		s.crown(rng, st, cnt, c)
+	}
+	s.fillPlayoff(st, cnt)
+}
+
+func (s *Simulator) apply(st *state, g *game, margin int32) {
+	winner, loser := g.home, g.away
+	switch {
+	case margin == 0:
+		return
+	case margin < 0:
+		winner, loser = loser, winner
+	}
+
+	st.wins[winner]++
+	if !s.teams[loser].fbs {
+		st.fcsWins[winner]++
+	}
+	if g.conference {
+		st.confWins[winner]++
+		st.confLosses[loser]++
+	}
+}
+
+// crown decides the champion of c, playing its title game when it has
+// one.
+func (s *Simulator) crown(
+	rng *rand.Rand, st *state, cnt *counts, c *conference,
+) {
+	st.standings = append(st.standings[:0], c.members...)
+	slices.SortFunc(st.standings, func(a, b int) int {
+		return cmp.Or(
+			cmp.Compare(st.confWins[b], st.confWins[a]),
+			cmp.Compare(st.confLosses[a], st.confLosses[b]),
+			s.compare(st, a, b),
+		)
+	})
+
+	winner := st.standings[0]
+	if c.hasTitle {
+		a, b, p := s.titlePair(st, c)
+		cnt.title[a]++
+		if b != a {
+			cnt.title[b]++
+		}
+		winner = b
+		if rng.Float64() < p {
+			winner = a
+		}
+	}
+	cnt.champion[winner]++
+	st.champion[winner] = true
+}
+
+// titlePair returns the title game teams and the probability that the
+// first wins: the scheduled title game if there is one, otherwise the top
+// two of the standings.
+func (s *Simulator) titlePair(st *state, c *conference) (int, int, float64) {
+	if c.title != nil {
+		return c.title.a, c.title.b, c.title.p
+	}
+	if len(st.standings) < 2 {
+		return st.standings[0], st.standings[0], 1
+	}
+
+	a, b := st.standings[0], st.standings[1]
+
+	return a, b, c.odds[c.pos[a]][c.pos[b]]
+}
+
+// fillPlayoff fills the playoff field: the best ranked champions take the
+// automatic bids and the best ranked other teams the rest, seeded by
+// ranking.
+func (s *Simulator) fillPlayoff(st *state, cnt *counts) {
+	if s.playoffSize <= 0 {
+		return
+	}
+
+	st.order = append(st.order[:0], s.fbs...)
+	slices.SortFunc(st.order, func(a, b int) int {
+		return cmp.Or(
+			cmp.Compare(s.losses(st, a), s.losses(st, b)),
+			s.compare(st, a, b),
+		)
+	})
+
+	st.field = st.field[:0]
+	for _, t := range st.order {
+		if len(st.field) < s.autoBids && st.champion[t] {
+			st.field = append(st.field, t)
+		}
+	}
+	for _, t := range st.order {
+		if len(st.field) >= s.playoffSize {
+			break
+		}
+		if !slices.Contains(st.field, t) {
+			st.field = append(st.field, t)
+		}
+	}
+	// Seed in ranking order, which is the order of the ranked list.
+	seeded := 0
+	for _, t := range st.order {
+		if slices.Contains(st.field, t) {
+			cnt.playoff[t]++
+			cnt.seeds[t][seeded]++
+			seeded++
+		}
+	}
+}
+
+func (s *Simulator) losses(st *state, t int) int {
+	return s.teams[t].played - st.wins[t] - s.teams[t].ties
+}
+
+// compare orders teams by wins, then power, then name.
+func (s *Simulator) compare(st *state, a, b int) int {
+	return cmp.Or(
+		cmp.Compare(st.wins[b], st.wins[a]),
+		cmp.Compare(s.power[b], s.power[a]),
+		cmp.Compare(s.teams[a].name, s.teams[b].name),
+	)
+}
+
+func (s *Simulator) result(runs int, total *counts) Result {
+	n := float64(runs)
+	res := Result{
+		Runs:      runs,
+		Seed:      s.seed,
+		Unmodeled: s.unmodeled,
+	}
+	for i, t := range s.teams {
+		out := Team{
+			Team:         t.name,
+			Conference:   t.conference,
+			FBS:          t.fbs,
+			TitleGame:    float64(total.title[i]) / n,
+			Champion:     float64(total.champion[i]) / n,
+			BowlEligible: float64(total.bowl[i]) / n,
+			Playoff:      float64(total.playoff[i]) / n,
+		}
+		for w := t.played; w >= 0; w-- {
+			c := total.records[i][w]
+			if c == 0 {
+				continue
+			}
+			p := float64(c) / n
+			out.Records = append(out.Records, Record{
+				Wins:        w,
+				Losses:      t.played - w - t.ties,
+				Ties:        t.ties,
+				Probability: p,
+			})
+			out.ExpectedWins += float64(w) * p
+		}
+		for _, c := range total.seeds[i] {
+			out.Seeds = append(out.Seeds, float64(c)/n)
+		}
+		res.Teams = append(res.Teams, out)
+	}
+	slices.SortFunc(res.Teams, func(a, b Team) int {
+		return cmp.Compare(a.Team, b.Team)
+	})
+
+	return res
+}
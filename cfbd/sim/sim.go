@@ -0,0 +1,214 @@
+// Package sim simulates the rest of a season many times over from the
+// games already played and a model of the games still to come, and reports
+// the distribution of final records, conference title game appearances,
+// bowl eligibility and playoff seeding.
+package sim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// ErrInvalidRuns is returned when asked for fewer than one run.
+var ErrInvalidRuns = errors.New("runs must be positive")
+
+// Source is the subset of *cfbd.Client used to build a Simulator.
+type Source interface {
+	GetGames(
+		ctx context.Context, request cfbd.GetGamesRequest,
+	) ([]*cfbd.Game, error)
+}
+
+var _ Source = (*cfbd.Client)(nil)
+
+const (
+	// SeasonTypeRegular is the season type Build fetches.
+	SeasonTypeRegular = "regular"
+	// Independents is the conference of FBS teams without one, which has no
+	// title game.
+	Independents = "FBS Independents"
+
+	classificationFBS = "fbs"
+	// chunkSize is the number of runs drawn from one random stream. Chunks
+	// are fixed so that results depend on the seed but not on the number
+	// of workers.
+	chunkSize = 1000
+)
+
+// Option configures a Simulator.
+type Option func(*Simulator)
+
+// WithSeed sets the random seed. Runs with the same seed, games and model
+// give the same result. The default is 1.
+func WithSeed(seed uint64) Option {
+	return func(s *Simulator) {
+		s.seed = seed
+	}
+}
+
+// WithWorkers sets how many goroutines run simulations. The default is
+// runtime.GOMAXPROCS(0).
+func WithWorkers(n int) Option {
+	return func(s *Simulator) {
+		s.workers = n
+	}
+}
+
+// WithBowlWins sets the wins needed for bowl eligibility. Only one win
+// against a non-FBS opponent counts. The default is 6.
+func WithBowlWins(n int) Option {
+	return func(s *Simulator) {
+		s.bowlWins = n
+	}
+}
+
+// WithTitleGames sets the conferences that play a title game. By default
+// every FBS conference except the independents does.
+func WithTitleGames(conferences ...string) Option {
+	return func(s *Simulator) {
+		s.titleConferences = append([]string{}, conferences...)
+	}
+}
+
+// WithPlayoff sets the size of the playoff field and how many of its spots
+// go to the best ranked conference champions. Teams are seeded in ranking
+// order. The default is 12 teams with 5 champions; a size of 0 or less
+// disables the playoff. autoBids is clamped to the field.
+func WithPlayoff(size, autoBids int) Option {
+	return func(s *Simulator) {
+		s.playoffSize = max(size, 0)
+		s.autoBids = min(max(autoBids, 0), s.playoffSize)
+	}
+}
+
+// WithPower sets the strength used to break ties in standings and playoff
+// rankings between teams with the same record. The default is each team's
+// expected wins under the model.
+func WithPower(power func(team string) float64) Option {
+	return func(s *Simulator) {
+		s.powerFunc = power
+	}
+}
+
+// Record is a final regular season record and its probability.
+type Record struct {
+	Wins        int
+	Losses      int
+	Ties        int
+	Probability float64
+}
+
+// Team is the simulated outlook of one team.
+type Team struct {
+	Team       string
+	Conference string
+	FBS        bool
+	// Records is the distribution of final records, most wins first.
+	Records      []Record
+	ExpectedWins float64
+	TitleGame    float64
+	Champion     float64
+	BowlEligible float64
+	Playoff      float64
+	// Seeds holds the probability of each playoff seed, the first seed
+	// first.
+	Seeds []float64
+}
+
+// Result is the outcome of a simulation.
+type Result struct {
+	Runs int
+	Seed uint64
+	// Teams are ordered by name.
+	Teams []Team
+	// Unmodeled lists the IDs of games the model had no answer for, which
+	// were simulated as coin flips.
+	Unmodeled []int32
+}
+
+// Team returns the outlook of team.
+func (r Result) Team(team string) (Team, bool) {
+	i := slices.IndexFunc(r.Teams, func(t Team) bool {
+		return t.Team == team
+	})
+	if i < 0 {
+		return Team{}, false
+	}
+
+	return r.Teams[i], true
+}
+
+// Simulator simulates a season. It is safe for concurrent use once built.
+type Simulator struct {
+	seed             uint64
+	workers          int
+	bowlWins         int
+	titleConferences []string
+	playoffSize      int
+	autoBids         int
+	powerFunc        func(team string) float64
+
+	teams       []teamInfo
+	index       map[string]int
+	games       []game
+	conferences []*conference
+	fbs         []int
+	power       []float64
+	unmodeled   []int32
+}
+
+// Build fetches the regular season games of year from src and returns a
+// simulator over them.
+func Build(
+	ctx context.Context, src Source, year int32, model Model, opts ...Option,
+) (*Simulator, error) {
+	games, err := src.GetGames(ctx, cfbd.GetGamesRequest{
+		Year: year, SeasonType: SeasonTypeRegular,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get %d games; %w", year, err)
+	}
+
+	return New(games, model, opts...), nil
+}
+
+// New builds a simulator over a season's games. Completed games keep their
+// results; the rest are decided by model. Games whose notes name them a
+// championship between two members of a title game conference are treated
+// as that conference's title game rather than as part of the regular
+// season.
+func New(games []*cfbd.Game, model Model, opts ...Option) *Simulator {
+	s := &Simulator{
+		seed:        1,
+		workers:     runtime.GOMAXPROCS(0),
+		bowlWins:    6,
+		playoffSize: 12,
+		autoBids:    5,
+		index:       make(map[string]int),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	for _, g := range games {
+		s.team(g.GetHomeTeam(), g.GetHomeConference(),
+			g.GetHomeClassification())
+		s.team(g.GetAwayTeam(), g.GetAwayConference(),
+			g.GetAwayClassification())
+	}
+	titles := s.buildConferences(games)
+	for _, g := range games {
+		if !titles[g] {
+			s.games = append(s.games, s.newGame(g, model))
+		}
+	}
+	s.buildConferenceOdds(model)
+	s.buildPower()
+
+	return s
+}
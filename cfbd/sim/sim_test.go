@@ -0,0 +1,227 @@
+package sim
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type side struct {
+	team, conference, classification string
+}
+
+var (
+	teamI   = side{"I", Independents, classificationFBS}
+	montana = side{"Montana", "Big Sky", "fcs"}
+	idaho   = side{"Idaho", "Big Sky", "fcs"}
+)
+
+func fbs(team, conference string) side {
+	return side{team, conference, classificationFBS}
+}
+
+func matchup(id int32, home, away side) *cfbd.Game {
+	return &cfbd.Game{
+		Id:                 id,
+		HomeTeam:           home.team,
+		HomeConference:     home.conference,
+		HomeClassification: home.classification,
+		AwayTeam:           away.team,
+		AwayConference:     away.conference,
+		AwayClassification: away.classification,
+	}
+}
+
+func final(g *cfbd.Game, homePts, awayPts int32) *cfbd.Game {
+	g.Completed = true
+	g.HomePoints, g.AwayPoints = &homePts, &awayPts
+	return g
+}
+
+// league plays a round robin in two four-team conferences, where the
+// earlier letter is at home, plus games against an independent and FCS
+// teams.
+func league() []*cfbd.Game {
+	var games []*cfbd.Game
+	id := int32(0)
+	for _, c := range []struct {
+		conference string
+		teams      []string
+	}{
+		{"East", []string{"A", "B", "C", "D"}},
+		{"West", []string{"E", "F", "G", "H"}},
+	} {
+		conference, teams := c.conference, c.teams
+		for i, home := range teams {
+			for _, away := range teams[i+1:] {
+				id++
+				games = append(games, matchup(id,
+					fbs(home, conference), fbs(away, conference)))
+			}
+		}
+	}
+
+	return append(games,
+		final(matchup(100, fbs("A", "East"), montana), 42, 0),
+		matchup(101, teamI, fbs("A", "East")),
+		matchup(102, teamI, fbs("E", "West")),
+	)
+}
+
+var homeWins = ModelFunc(func(*cfbd.Game) (float64, bool) {
+	return 1, true
+})
+
+func run(t *testing.T, s *Simulator, runs int) Result {
+	res, err := s.Run(context.Background(), runs)
+	require.NoError(t, err)
+
+	return res
+}
+
+func team(t *testing.T, res Result, name string) Team {
+	out, ok := res.Team(name)
+	require.True(t, ok, name)
+
+	return out
+}
+
+func TestRun_CertainModel_ShouldCrownAndSeed(t *testing.T) {
+	res := run(t, New(league(), homeWins, WithPlayoff(4, 2)), 10)
+
+	a := team(t, res, "A")
+	assert.Equal(t, []Record{{Wins: 4, Losses: 1, Probability: 1}}, a.Records)
+	assert.InDelta(t, 4, a.ExpectedWins, 1e-9)
+	assert.InDelta(t, 1, a.TitleGame, 1e-9)
+	assert.InDelta(t, 1, a.Champion, 1e-9)
+	assert.InDelta(t, 1, team(t, res, "B").TitleGame, 1e-9)
+	assert.Zero(t, team(t, res, "B").Champion)
+	assert.Zero(t, team(t, res, "C").TitleGame)
+	assert.Zero(t, team(t, res, "I").TitleGame)
+	assert.False(t, team(t, res, "Montana").FBS)
+
+	// I is unbeaten, the champions A and E take the automatic bids and B
+	// beats F for the last spot on expected wins.
+	for name, seed := range map[string]int{"I": 0, "A": 1, "E": 2, "B": 3} {
+		tm := team(t, res, name)
+		assert.InDelta(t, 1, tm.Playoff, 1e-9, name)
+		assert.InDelta(t, 1, tm.Seeds[seed], 1e-9, name)
+	}
+	assert.Zero(t, team(t, res, "F").Playoff)
+	assert.Empty(t, res.Unmodeled)
+}
+
+func TestRun_MoreAutoBidsThanSpots_ShouldClampToField(t *testing.T) {
+	res := run(t, New(league(), homeWins, WithPlayoff(1, 2)), 10)
+
+	// The field holds one team however many champions there are.
+	i := team(t, res, "I")
+	assert.InDelta(t, 1, i.Playoff, 1e-9)
+	assert.Equal(t, []float64{1}, i.Seeds)
+	assert.Zero(t, team(t, res, "A").Playoff)
+	assert.Zero(t, team(t, res, "E").Playoff)
+}
+
+func TestRun_NegativePlayoff_ShouldDisablePlayoff(t *testing.T) {
+	res := run(t, New(league(), homeWins, WithPlayoff(-4, 2)), 10)
+
+	assert.Zero(t, team(t, res, "I").Playoff)
+	assert.Empty(t, team(t, res, "I").Seeds)
+}
+
+func TestRun_ScheduledTitleGame_ShouldReplaceStandings(t *testing.T) {
+	title := matchup(200, fbs("C", "East"), fbs("D", "East"))
+	title.Notes = "East Championship"
+
+	res := run(t, New(append(league(), title), homeWins), 10)
+
+	assert.Zero(t, team(t, res, "A").TitleGame)
+	assert.InDelta(t, 1, team(t, res, "C").Champion, 1e-9)
+	assert.InDelta(t, 1, team(t, res, "D").TitleGame, 1e-9)
+	// The title game is not part of the regular season record.
+	assert.Equal(t, 1, team(t, res, "C").Records[0].Wins)
+}
+
+func TestRun_FCSWins_ShouldCountOnceForBowls(t *testing.T) {
+	games := append(league(),
+		final(matchup(103, fbs("A", "East"), idaho), 35, 3))
+
+	four := run(t, New(games, homeWins, WithBowlWins(4)), 10)
+	five := run(t, New(games, homeWins, WithBowlWins(5)), 10)
+
+	assert.InDelta(t, 1, team(t, four, "A").BowlEligible, 1e-9)
+	assert.Zero(t, team(t, five, "A").BowlEligible)
+	assert.Zero(t, team(t, four, "Montana").BowlEligible)
+}
+
+func TestRun_SameSeed_ShouldBeDeterministicAcrossWorkers(t *testing.T) {
+	coin := ModelFunc(func(*cfbd.Game) (float64, bool) { return 0.6, true })
+
+	games := league()
+
+	one := run(t, New(games, coin, WithSeed(7), WithWorkers(1)), 5500)
+	many := run(t, New(games, coin, WithSeed(7), WithWorkers(4)), 5500)
+	other := run(t, New(games, coin, WithSeed(8)), 5500)
+
+	assert.Equal(t, one, many)
+	assert.NotEqual(t, one.Teams, other.Teams)
+	var total float64
+	for _, r := range team(t, one, "B").Records {
+		total += r.Probability
+	}
+	assert.InDelta(t, 1, total, 1e-9)
+	assert.InDelta(t, 1.6, team(t, one, "B").ExpectedWins, 0.05)
+}
+
+func TestRun_NoModel_ShouldFlipCoinsAndReport(t *testing.T) {
+	never := ModelFunc(func(*cfbd.Game) (float64, bool) { return 0, false })
+
+	res := run(t, New(league(), never), 2000)
+
+	assert.Len(t, res.Unmodeled, 14)
+	assert.InDelta(t, 0.5, team(t, res, "I").Records[1].Probability, 0.05)
+}
+
+func TestRun_InvalidOrCanceled_ShouldError(t *testing.T) {
+	s := New(league(), homeWins)
+
+	_, err := s.Run(context.Background(), 0)
+	require.ErrorIs(t, err, ErrInvalidRuns)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = s.Run(ctx, 10)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+type fakeSource struct {
+	games []*cfbd.Game
+	err   error
+	got   cfbd.GetGamesRequest
+}
+
+func (f *fakeSource) GetGames(
+	_ context.Context, request cfbd.GetGamesRequest,
+) ([]*cfbd.Game, error) {
+	f.got = request
+	return f.games, f.err
+}
+
+func TestBuild_ShouldFetchRegularSeason(t *testing.T) {
+	src := &fakeSource{games: league()}
+
+	s, err := Build(context.Background(), src, 2025, homeWins)
+	require.NoError(t, err)
+
+	assert.Equal(t, SeasonTypeRegular, src.got.SeasonType)
+	assert.Len(t, run(t, s, 1).Teams, 10)
+
+	boom := errors.New("boom")
+	_, err = Build(context.Background(), &fakeSource{err: boom}, 2025,
+		homeWins)
+	assert.ErrorIs(t, err, boom)
+}
@@ -0,0 +1,39 @@
+package srs
+
+import (
+	"math"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// Comparison summarizes how closely solved ratings match published ones.
+type Comparison struct {
+	// Diffs holds solved minus published rating by team, for teams in
+	// both.
+	Diffs map[string]float64
+	// Missing lists published teams that were not solved.
+	Missing []string
+	MAE     float64
+	MaxAbs  float64
+}
+
+// Compare matches solved ratings to GetSRSRatings results by team name.
+func Compare(r Result, published []*cfbd.TeamSRS) Comparison {
+	c := Comparison{Diffs: make(map[string]float64, len(published))}
+	for _, p := range published {
+		x, ok := r.Rating(p.GetTeam())
+		if !ok {
+			c.Missing = append(c.Missing, p.GetTeam())
+			continue
+		}
+		d := x.Rating - p.GetRating()
+		c.Diffs[p.GetTeam()] = d
+		c.MAE += math.Abs(d)
+		c.MaxAbs = max(c.MaxAbs, math.Abs(d))
+	}
+	if n := float64(len(c.Diffs)); n > 0 {
+		c.MAE /= n
+	}
+
+	return c
+}
@@ -0,0 +1,328 @@
+// Package srs solves the Simple Rating System from game results: each
+// team's rating is its average scoring margin plus the average rating of
+// its opponents, so that ratings can be computed for any window of games
+// rather than only read from GetSRSRatings.
+package srs
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// ErrNoGames is returned when no completed games are left to solve.
+var ErrNoGames = errors.New("no completed games")
+
+// Option configures a Solver.
+type Option func(*Solver)
+
+// WithMarginCap limits each game's margin to points either way, so that
+// running up the score does not inflate ratings. The default is no cap.
+func WithMarginCap(points int32) Option {
+	return func(s *Solver) {
+		s.marginCap = points
+	}
+}
+
+// WithHomeField fixes the home field advantage in points. By default it is
+// estimated from the games alongside the ratings.
+func WithHomeField(points float64) Option {
+	return func(s *Solver) {
+		s.homeField = &points
+	}
+}
+
+// WithFilter solves only the games for which keep returns true, for
+// example conference games or the last six weeks.
+func WithFilter(keep func(*cfbd.Game) bool) Option {
+	return func(s *Solver) {
+		s.filter = keep
+	}
+}
+
+// WithTolerance sets the largest change in any rating between iterations
+// at which the solution counts as converged. The default is 1e-6.
+func WithTolerance(tolerance float64) Option {
+	return func(s *Solver) {
+		s.tolerance = tolerance
+	}
+}
+
+// WithMaxIterations bounds the number of iterations. The default is 10000.
+func WithMaxIterations(n int) Option {
+	return func(s *Solver) {
+		s.maxIterations = n
+	}
+}
+
+// Rating is one team's solved rating.
+type Rating struct {
+	Team       string
+	Conference string
+	// Rating is MOV plus SOS, in points above an average team.
+	Rating float64
+	// MOV is the average home-adjusted, capped scoring margin.
+	MOV float64
+	// SOS is the average rating of the team's opponents.
+	SOS   float64
+	Games int
+	// Ranking is the 1-based position by rating.
+	Ranking int32
+}
+
+// Result is a solved set of ratings.
+type Result struct {
+	// Ratings are ordered by rating, highest first.
+	Ratings []Rating
+	// HomeField is the home field advantage in points, estimated unless it
+	// was fixed with WithHomeField.
+	HomeField float64
+	// Iterations is the number of iterations run.
+	Iterations int
+	// Converged is false when the last iteration still changed a rating by
+	// more than the tolerance.
+	Converged bool
+	// Delta is the largest rating change in the last iteration.
+	Delta float64
+}
+
+// Rating returns the rating of team.
+func (r Result) Rating(team string) (Rating, bool) {
+	i := slices.IndexFunc(r.Ratings, func(x Rating) bool {
+		return x.Team == team
+	})
+	if i < 0 {
+		return Rating{}, false
+	}
+
+	return r.Ratings[i], true
+}
+
+// TeamSRS returns the ratings in the shape of GetSRSRatings.
+func (r Result) TeamSRS(year int32) []*cfbd.TeamSRS {
+	out := make([]*cfbd.TeamSRS, 0, len(r.Ratings))
+	for _, x := range r.Ratings {
+		out = append(out, &cfbd.TeamSRS{
+			Year:       year,
+			Team:       x.Team,
+			Conference: x.Conference,
+			Rating:     x.Rating,
+			Ranking:    &x.Ranking,
+		})
+	}
+
+	return out
+}
+
+// Solver computes SRS ratings.
+type Solver struct {
+	marginCap     int32
+	homeField     *float64
+	filter        func(*cfbd.Game) bool
+	tolerance     float64
+	maxIterations int
+}
+
+// New returns a solver.
+func New(opts ...Option) *Solver {
+	s := &Solver{
+		tolerance:     1e-6,
+		maxIterations: 10000,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// game is a completed game reduced to team indexes and a capped margin.
+type game struct {
+	home, away int
+	margin     float64
+	neutral    bool
+}
+
+// Solve rates every team in the completed games that pass the filter.
+//
+// Ratings are found by iterating r = MOV + SOS from zero, damped, until no
+// rating moves by more than the tolerance, which converges to the
+// least-squares solution of margin = home - away + home field. Ratings are
+// centered on zero after every iteration. When the home field advantage is
+// estimated, it is updated each iteration to the mean residual of
+// non-neutral games.
+func (s *Solver) Solve(games []*cfbd.Game) (Result, error) {
+	teams, conferences, played := s.collect(games)
+	if len(played) == 0 {
+		return Result{}, ErrNoGames
+	}
+
+	schedules := make([][]int, len(teams))
+	for i, g := range played {
+		schedules[g.home] = append(schedules[g.home], i)
+		schedules[g.away] = append(schedules[g.away], i)
+	}
+
+	res := Result{}
+	if s.homeField != nil {
+		res.HomeField = *s.homeField
+	}
+	ratings := make([]float64, len(teams))
+	next := make([]float64, len(teams))
+	for res.Iterations < s.maxIterations {
+		res.Iterations++
+		for t, schedule := range schedules {
+			mov, sos := split(t, schedule, played, ratings, res.HomeField)
+			// Averaging with the previous rating damps the oscillation
+			// plain iteration falls into when schedules split into two
+			// groups that only play each other.
+			next[t] = (ratings[t] + mov + sos) / 2
+		}
+		center(next)
+		res.Delta = maxDelta(ratings, next)
+		ratings, next = next, ratings
+		if s.homeField == nil {
+			res.HomeField = estimateHomeField(played, ratings)
+		}
+		if res.Delta <= s.tolerance {
+			res.Converged = true
+			break
+		}
+	}
+
+	for t, team := range teams {
+		mov, sos := split(t, schedules[t], played, ratings, res.HomeField)
+		res.Ratings = append(res.Ratings, Rating{
+			Team:       team,
+			Conference: conferences[t],
+			Rating:     ratings[t],
+			MOV:        mov,
+			SOS:        sos,
+			Games:      len(schedules[t]),
+		})
+	}
+	rank(res.Ratings)
+
+	return res, nil
+}
+
+// collect indexes the teams of the completed games that pass the filter.
+func (s *Solver) collect(
+	games []*cfbd.Game,
+) ([]string, []string, []game) {
+	index := make(map[string]int)
+	var teams, conferences []string
+	id := func(team, conference string) int {
+		i, ok := index[team]
+		if !ok {
+			i = len(teams)
+			index[team] = i
+			teams = append(teams, team)
+			conferences = append(conferences, conference)
+		}
+		return i
+	}
+
+	var played []game
+	for _, g := range games {
+		if !g.GetCompleted() || g.HomePoints == nil || g.AwayPoints == nil {
+			continue
+		}
+		if s.filter != nil && !s.filter(g) {
+			continue
+		}
+		margin := g.GetHomePoints() - g.GetAwayPoints()
+		if s.marginCap > 0 {
+			margin = min(max(margin, -s.marginCap), s.marginCap)
+		}
+		played = append(played, game{
+			home:    id(g.GetHomeTeam(), g.GetHomeConference()),
+			away:    id(g.GetAwayTeam(), g.GetAwayConference()),
+			margin:  float64(margin),
+			neutral: g.GetNeutralSite(),
+		})
+	}
+
+	return teams, conferences, played
+}
+
+// split returns team t's average home-adjusted margin and the average
+// rating of its opponents.
+func split(
+	t int, schedule []int, played []game, ratings []float64, homeField float64,
+) (float64, float64) {
+	if len(schedule) == 0 {
+		return 0, 0
+	}
+
+	var mov, sos float64
+	for _, i := range schedule {
+		g := played[i]
+		margin := g.margin
+		if !g.neutral {
+			margin -= homeField
+		}
+		if g.home == t {
+			mov += margin
+			sos += ratings[g.away]
+		} else {
+			mov -= margin
+			sos += ratings[g.home]
+		}
+	}
+	n := float64(len(schedule))
+
+	return mov / n, sos / n
+}
+
+func estimateHomeField(played []game, ratings []float64) float64 {
+	var sum float64
+	var n int
+	for _, g := range played {
+		if g.neutral {
+			continue
+		}
+		sum += g.margin - (ratings[g.home] - ratings[g.away])
+		n++
+	}
+	if n == 0 {
+		return 0
+	}
+
+	return sum / float64(n)
+}
+
+func center(ratings []float64) {
+	var sum float64
+	for _, r := range ratings {
+		sum += r
+	}
+	mean := sum / float64(len(ratings))
+	for i := range ratings {
+		ratings[i] -= mean
+	}
+}
+
+func maxDelta(a, b []float64) float64 {
+	var d float64
+	for i := range a {
+		d = max(d, math.Abs(a[i]-b[i]))
+	}
+
+	return d
+}
+
+func rank(ratings []Rating) {
+	slices.SortFunc(ratings, func(a, b Rating) int {
+		return cmp.Or(
+			cmp.Compare(b.Rating, a.Rating),
+			cmp.Compare(a.Team, b.Team),
+		)
+	})
+	for i := range ratings {
+		ratings[i].Ranking = int32(i + 1)
+	}
+}
@@ -0,0 +1,144 @@
+package srs
+
+import (
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// published returns the fixture's TeamSRS for Texas plus three made-up
+// teams, centered on zero like the published ratings.
+func published(t *testing.T) []*cfbd.TeamSRS {
+	out := fixture.Load(t, "ratings_srs.json", func() *cfbd.TeamSRS {
+		return &cfbd.TeamSRS{}
+	})
+	for team, rating := range map[string]float64{
+		"Alabama": 5, "Kentucky": -3, "Arkansas": -14,
+	} {
+		out = append(out, &cfbd.TeamSRS{Year: 2025, Team: team,
+			Conference: "SEC", Rating: rating})
+	}
+
+	return out
+}
+
+func final(home, away string, homePts, awayPts int32) *cfbd.Game {
+	return &cfbd.Game{
+		Completed:      true,
+		HomeTeam:       home,
+		HomeConference: "SEC",
+		HomePoints:     &homePts,
+		AwayTeam:       away,
+		AwayConference: "SEC",
+		AwayPoints:     &awayPts,
+	}
+}
+
+// schedule plays a double round robin whose margins follow the published
+// ratings exactly, with the given home field advantage.
+func schedule(ratings []*cfbd.TeamSRS, homeField float64) []*cfbd.Game {
+	var games []*cfbd.Game
+	for _, h := range ratings {
+		for _, a := range ratings {
+			if h == a {
+				continue
+			}
+			margin := int32(h.GetRating() - a.GetRating() + homeField)
+			games = append(games, final(h.GetTeam(), a.GetTeam(),
+				max(margin, 0)+20, max(-margin, 0)+20))
+		}
+	}
+
+	return games
+}
+
+func TestSolve_ConsistentMargins_ShouldReproduceTeamSRS(t *testing.T) {
+	want := published(t)
+
+	res, err := New().Solve(schedule(want, 3))
+	require.NoError(t, err)
+	c := Compare(res, want)
+
+	assert.True(t, res.Converged)
+	assert.InDelta(t, 3, res.HomeField, 1e-4)
+	assert.Empty(t, c.Missing)
+	assert.Less(t, c.MaxAbs, 1e-4)
+	assert.Equal(t, "Texas", res.Ratings[0].Team)
+	assert.Equal(t, int32(1), res.Ratings[0].Ranking)
+	assert.InDelta(t, 12, res.TeamSRS(2025)[0].GetRating(), 1e-4)
+}
+
+func TestSolve_NeutralSite_ShouldSkipHomeField(t *testing.T) {
+	g := final("A", "B", 27, 17)
+	g.NeutralSite = true
+
+	res, err := New(WithHomeField(3)).Solve([]*cfbd.Game{
+		g, final("B", "A", 20, 17),
+	})
+	require.NoError(t, err)
+
+	a, ok := res.Rating("A")
+	require.True(t, ok)
+	assert.True(t, res.Converged)
+	assert.InDelta(t, 3, res.HomeField, 1e-9)
+	// A won by 10 on a neutral field and lost by 3 away, 0 adjusted.
+	assert.InDelta(t, 2.5, a.Rating, 1e-4)
+	assert.InDelta(t, 5, a.MOV, 1e-4)
+	assert.Equal(t, 2, a.Games)
+}
+
+func TestSolve_MarginCap_ShouldLimitBlowouts(t *testing.T) {
+	games := []*cfbd.Game{final("A", "B", 70, 0)}
+
+	res, err := New(WithMarginCap(28), WithHomeField(0)).Solve(games)
+	require.NoError(t, err)
+
+	a, _ := res.Rating("A")
+	assert.InDelta(t, 14, a.Rating, 1e-4)
+}
+
+func TestSolve_Filter_ShouldSolveWindowOnly(t *testing.T) {
+	fcs := final("A", "Montana", 56, 0)
+	fcs.AwayConference = "Big Sky"
+	games := []*cfbd.Game{fcs, final("A", "B", 10, 7)}
+
+	res, err := New(
+		WithHomeField(0),
+		WithFilter(func(g *cfbd.Game) bool {
+			return g.GetHomeConference() == g.GetAwayConference()
+		}),
+	).Solve(games)
+	require.NoError(t, err)
+
+	require.Len(t, res.Ratings, 2)
+	_, ok := res.Rating("Montana")
+	assert.False(t, ok)
+}
+
+func TestSolve_MaxIterations_ShouldReportNotConverged(t *testing.T) {
+	res, err := New(WithMaxIterations(1)).Solve(schedule(published(t), 3))
+	require.NoError(t, err)
+
+	assert.False(t, res.Converged)
+	assert.Equal(t, 1, res.Iterations)
+	assert.Positive(t, res.Delta)
+}
+
+func TestSolve_NoCompletedGames_ShouldError(t *testing.T) {
+	_, err := New().Solve([]*cfbd.Game{{HomeTeam: "A", AwayTeam: "B"}})
+
+	assert.ErrorIs(t, err, ErrNoGames)
+}
+
+func TestCompare_UnsolvedTeam_ShouldListMissing(t *testing.T) {
+	res, err := New().Solve([]*cfbd.Game{final("A", "B", 10, 7)})
+	require.NoError(t, err)
+
+	c := Compare(res, published(t))
+
+	assert.Len(t, c.Missing, 4)
+	assert.Empty(t, c.Diffs)
+}
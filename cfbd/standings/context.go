@@ -0,0 +1,159 @@
+package standings
+
+import "slices"
+
+// Record is a won-lost-tied record.
+type Record struct {
+	Wins   int
+	Losses int
+	Ties   int
+}
+
+// Games returns the number of games in the record.
+func (r Record) Games() int {
+	return r.Wins + r.Losses + r.Ties
+}
+
+// Pct returns the winning percentage, counting ties as half a win, or 0
+// without games.
+func (r Record) Pct() float64 {
+	if r.Games() == 0 {
+		return 0
+	}
+
+	return (float64(r.Wins) + float64(r.Ties)/2) / float64(r.Games())
+}
+
+func (r *Record) add(margin int32) {
+	switch {
+	case margin > 0:
+		r.Wins++
+	case margin < 0:
+		r.Losses++
+	default:
+		r.Ties++
+	}
+}
+
+// result is one conference game from a team's side.
+type result struct {
+	opponent string
+	margin   int32
+}
+
+// Context is what rules see of a conference while breaking a tie.
+type Context struct {
+	Season     int32
+	Conference string
+	Division   string
+
+	results map[string][]result
+	overall map[string]Record
+	order   [][]string
+	finish  [][]string
+}
+
+// Against returns team's record in conference games against opponents.
+func (c *Context) Against(team string, opponents ...string) Record {
+	var r Record
+	for _, res := range c.results[team] {
+		if slices.Contains(opponents, res.opponent) {
+			r.add(res.margin)
+		}
+	}
+
+	return r
+}
+
+// ConferenceRecord returns team's record in conference games.
+func (c *Context) ConferenceRecord(team string) Record {
+	var r Record
+	for _, res := range c.results[team] {
+		r.add(res.margin)
+	}
+
+	return r
+}
+
+// Overall returns team's record in all games.
+func (c *Context) Overall(team string) Record {
+	return c.overall[team]
+}
+
+// Opponents returns the conference opponents team played.
+func (c *Context) Opponents(team string) []string {
+	var out []string
+	for _, res := range c.results[team] {
+		if !slices.Contains(out, res.opponent) {
+			out = append(out, res.opponent)
+		}
+	}
+
+	return out
+}
+
+// Order returns the standings before ties are broken: groups of teams with
+// the same conference winning percentage, best first.
+func (c *Context) Order() [][]string {
+	return c.order
+}
+
+// Finish returns the whole conference's standings in order of finish, with
+// ties broken, across divisions. Teams no rule separated share a group.
+// While the conference's own standings are being ordered, before any
+// finish is known, it returns Order.
+func (c *Context) Finish() [][]string {
+	if c.finish == nil {
+		return c.order
+	}
+
+	return c.finish
+}
+
+func (c *Context) played(team, opponent string) bool {
+	return c.Against(team, opponent).Games() > 0
+}
+
+// roundRobin reports whether every pair of teams played.
+func (c *Context) roundRobin(teams []string) bool {
+	for i, a := range teams {
+		for _, b := range teams[i+1:] {
+			if !c.played(a, b) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// allPlayed reports whether every team played at least one of opponents.
+func (c *Context) allPlayed(teams, opponents []string) bool {
+	for _, t := range teams {
+		if c.Against(t, opponents...).Games() == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// commonOpponents returns the conference opponents every team played,
+// other than the teams themselves.
+func (c *Context) commonOpponents(teams []string) []string {
+	if len(teams) == 0 {
+		return nil
+	}
+
+	var common []string
+	for _, opp := range c.Opponents(teams[0]) {
+		if slices.Contains(teams, opp) {
+			continue
+		}
+		if c.allPlayed(teams, []string{opp}) {
+			common = append(common, opp)
+		}
+	}
+
+	return common
+}
@@ -0,0 +1,177 @@
+package standings
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Rule breaks a tie between teams.
+type Rule interface {
+	// Name describes the rule in Standing.Tiebreaker.
+	Name() string
+	// Split orders tied teams into groups, best first. A single group
+	// means the rule could not separate them.
+	Split(c *Context, tied []string) [][]string
+}
+
+type rule struct {
+	name  string
+	split func(c *Context, tied []string) [][]string
+}
+
+func (r rule) Name() string {
+	return r.name
+}
+
+func (r rule) Split(c *Context, tied []string) [][]string {
+	return r.split(c, tied)
+}
+
+// NewRule returns a rule from a name and a split function.
+func NewRule(
+	name string, split func(c *Context, tied []string) [][]string,
+) Rule {
+	return rule{name: name, split: split}
+}
+
+// Partition groups teams by descending score; teams with equal scores
+// share a group.
+func Partition(teams []string, score func(team string) float64) [][]string {
+	sorted := slices.Clone(teams)
+	slices.SortStableFunc(sorted, func(a, b string) int {
+		return cmp.Compare(score(b), score(a))
+	})
+
+	var groups [][]string
+	for i, team := range sorted {
+		if i > 0 && score(team) == score(sorted[i-1]) {
+			groups[len(groups)-1] = append(groups[len(groups)-1], team)
+			continue
+		}
+		groups = append(groups, []string{team})
+	}
+
+	return groups
+}
+
+// HeadToHead compares the tied teams' records in games between them. When
+// more than two teams are tied and not all of them played each other, it
+// only separates a team that beat all the others or lost to all of them.
+func HeadToHead() Rule {
+	return NewRule("head-to-head", func(c *Context, tied []string) [][]string {
+		if c.roundRobin(tied) {
+			return Partition(tied, func(team string) float64 {
+				return c.Against(team, tied...).Pct()
+			})
+		}
+
+		for _, team := range tied {
+			r := c.Against(team, tied...)
+			switch {
+			case r.Wins == len(tied)-1 && r.Losses == 0 && r.Ties == 0:
+				return [][]string{{team}, without(tied, team)}
+			case r.Losses == len(tied)-1 && r.Wins == 0 && r.Ties == 0:
+				return [][]string{without(tied, team), {team}}
+			}
+		}
+
+		return [][]string{tied}
+	})
+}
+
+// CommonOpponents compares the tied teams' records against the conference
+// opponents all of them played.
+func CommonOpponents() Rule {
+	return NewRule("common opponents",
+		func(c *Context, tied []string) [][]string {
+			common := c.commonOpponents(tied)
+			if len(common) == 0 {
+				return [][]string{tied}
+			}
+			return Partition(tied, func(team string) float64 {
+				return c.Against(team, common...).Pct()
+			})
+		})
+}
+
+// OpponentsInOrder compares the tied teams' records against common
+// conference opponents taken in the conference's order of finish, from
+// the top, until one separates them. Opponents still tied after every
+// tiebreaker are taken together.
+func OpponentsInOrder() Rule {
+	return NewRule("common opponents in order of finish",
+		func(c *Context, tied []string) [][]string {
+			for _, group := range c.Finish() {
+				group = without(group, tied...)
+				if len(group) == 0 || !c.allPlayed(tied, group) {
+					continue
+				}
+				split := Partition(tied, func(team string) float64 {
+					return c.Against(team, group...).Pct()
+				})
+				if len(split) > 1 {
+					return split
+				}
+			}
+			return [][]string{tied}
+		})
+}
+
+// OverallRecord compares the tied teams' records in all games.
+func OverallRecord() Rule {
+	return NewRule("overall record", func(c *Context, tied []string) [][]string {
+		return Partition(tied, func(team string) float64 {
+			return c.Overall(team).Pct()
+		})
+	})
+}
+
+// Ranking compares the tied teams' positions in a ranking such as the CFP
+// rankings, where 1 is best. Unranked teams come last.
+func Ranking(name string, ranks map[string]int) Rule {
+	return NewRule(name, func(_ *Context, tied []string) [][]string {
+		return Partition(tied, func(team string) float64 {
+			if r, ok := ranks[team]; ok {
+				return -float64(r)
+			}
+			return math.Inf(-1)
+		})
+	})
+}
+
+// Rating compares the tied teams' ratings, highest first. Unrated teams
+// come last.
+func Rating(name string, ratings map[string]float64) Rule {
+	return NewRule(name, func(_ *Context, tied []string) [][]string {
+		return Partition(tied, func(team string) float64 {
+			if r, ok := ratings[team]; ok {
+				return r
+			}
+			return math.Inf(-1)
+		})
+	})
+}
+
+// DefaultRules is the rule set used for conferences without their own:
+// head-to-head, common opponents, common opponents in order of finish and
+// overall record.
+func DefaultRules() []Rule {
+	return []Rule{
+		HeadToHead(),
+		CommonOpponents(),
+		OpponentsInOrder(),
+		OverallRecord(),
+	}
+}
+
+func without(teams []string, drop ...string) []string {
+	out := make([]string, 0, len(teams))
+	for _, t := range teams {
+		if !slices.Contains(drop, t) {
+			out = append(out, t)
+		}
+	}
+
+	return out
+}
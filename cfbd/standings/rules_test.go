@@ -0,0 +1,80 @@
+package standings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// played builds a context from conference games given as winner, loser
+// pairs.
+func played(games ...[2]string) *Context {
+	c := &Context{results: make(map[string][]result)}
+	for _, g := range games {
+		c.results[g[0]] = append(c.results[g[0]], result{g[1], 7})
+		c.results[g[1]] = append(c.results[g[1]], result{g[0], -7})
+	}
+
+	return c
+}
+
+func TestPartition_ShouldGroupEqualScoresBestFirst(t *testing.T) {
+	score := map[string]float64{"A": 1, "B": 3, "C": 1}
+
+	got := Partition([]string{"A", "B", "C"}, func(team string) float64 {
+		return score[team]
+	})
+
+	assert.Equal(t, [][]string{{"B"}, {"A", "C"}}, got)
+}
+
+func TestHeadToHead_IncompleteRoundRobin_ShouldOnlySeparateSweeps(
+	t *testing.T,
+) {
+	tied := []string{"A", "B", "C"}
+
+	sweep := played([2]string{"A", "B"}, [2]string{"A", "C"})
+	swept := played([2]string{"B", "A"}, [2]string{"C", "A"})
+	split := played([2]string{"A", "B"}, [2]string{"C", "A"})
+
+	assert.Equal(t, [][]string{{"A"}, {"B", "C"}},
+		HeadToHead().Split(sweep, tied))
+	assert.Equal(t, [][]string{{"B", "C"}, {"A"}},
+		HeadToHead().Split(swept, tied))
+	assert.Equal(t, [][]string{tied}, HeadToHead().Split(split, tied))
+}
+
+func TestCommonOpponents_ShouldCompareSharedOpponentsOnly(t *testing.T) {
+	c := played(
+		[2]string{"A", "D"}, [2]string{"E", "A"},
+		[2]string{"D", "B"}, [2]string{"E", "B"}, [2]string{"B", "F"},
+	)
+
+	got := CommonOpponents().Split(c, []string{"A", "B"})
+
+	// F is not common, so A's 1-1 beats B's 0-2.
+	assert.Equal(t, [][]string{{"A"}, {"B"}}, got)
+	assert.Equal(t, [][]string{{"A", "C"}},
+		CommonOpponents().Split(c, []string{"A", "C"}))
+}
+
+func TestOpponentsInOrder_ShouldUseFirstSeparatingOpponent(t *testing.T) {
+	c := played(
+		[2]string{"E", "A"}, [2]string{"E", "B"},
+		[2]string{"A", "F"}, [2]string{"F", "B"},
+	)
+	c.order = [][]string{{"E"}, {"A", "B"}, {"F"}}
+
+	got := OpponentsInOrder().Split(c, []string{"A", "B"})
+
+	assert.Equal(t, [][]string{{"A"}, {"B"}}, got)
+}
+
+func TestRating_ShouldPutUnratedLast(t *testing.T) {
+	r := Rating("SP+", map[string]float64{"A": -3, "B": 10})
+
+	got := r.Split(&Context{}, []string{"A", "B", "C"})
+
+	assert.Equal(t, [][]string{{"B"}, {"A"}, {"C"}}, got)
+	assert.Equal(t, "SP+", r.Name())
+}
@@ -0,0 +1,374 @@
+// Package standings orders conference standings from game results and
+// conference membership, breaking ties with rule sets that can differ by
+// conference and season.
+package standings
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+const (
+	// Independents is the conference of FBS teams without one, which has
+	// no standings.
+	Independents = "FBS Independents"
+	// Unresolved is the tiebreaker of teams no rule could separate, which
+	// are then ordered by name.
+	Unresolved = "unresolved"
+)
+
+// Source is the subset of *cfbd.Client used to build a Builder.
+type Source interface {
+	GetTeams(
+		ctx context.Context, request cfbd.GetTeamsRequest,
+	) ([]*cfbd.Team, error)
+	GetConferences(ctx context.Context) ([]*cfbd.Conference, error)
+}
+
+var _ Source = (*cfbd.Client)(nil)
+
+// Option configures a Builder.
+type Option func(*Builder)
+
+// WithRules sets the tiebreaker rules of a conference, named by its name,
+// short name or abbreviation, from season on until a later season sets
+// its own. A season of 0 applies to every season.
+func WithRules(conference string, season int32, rules ...Rule) Option {
+	return func(b *Builder) {
+		b.rules[conference] = append(b.rules[conference], ruleSet{
+			season: season,
+			rules:  slices.Clone(rules),
+		})
+	}
+}
+
+// WithDefaultRules sets the tiebreaker rules of conferences without their
+// own. The default is DefaultRules.
+func WithDefaultRules(rules ...Rule) Option {
+	return func(b *Builder) {
+		b.defaults = slices.Clone(rules)
+	}
+}
+
+// WithConferences limits the standings to conferences, and lets rules name
+// them by short name or abbreviation.
+func WithConferences(conferences []*cfbd.Conference) Option {
+	return func(b *Builder) {
+		b.conferences = make(map[string][]string)
+		for _, c := range conferences {
+			b.conferences[c.GetName()] = []string{
+				c.GetName(), c.GetShortName(), c.GetAbbreviation(),
+			}
+		}
+	}
+}
+
+// WithoutDivisions ranks each conference as a whole even when its teams
+// belong to divisions.
+func WithoutDivisions() Option {
+	return func(b *Builder) {
+		b.noDivisions = true
+	}
+}
+
+// ruleSet is a conference's rules from season on.
+type ruleSet struct {
+	season int32
+	rules  []Rule
+}
+
+type member struct {
+	conference string
+	division   string
+}
+
+// Standing is one team's place in its conference or division.
+type Standing struct {
+	// Rank is the team's place, from 1.
+	Rank       int
+	Team       string
+	Conference string
+	Division   string
+	// ConferenceRecord counts conference games only.
+	ConferenceRecord Record
+	Overall          Record
+	// Tiebreaker names the rule that decided the team's place among the
+	// teams it was tied with, or is empty when it was not tied.
+	Tiebreaker string
+}
+
+// Table is the standings of a conference or one of its divisions.
+type Table struct {
+	Conference string
+	Division   string
+	Season     int32
+	Standings  []Standing
+}
+
+// Builder orders standings for a set of conference members.
+type Builder struct {
+	members     map[string]member
+	conferences map[string][]string
+	defaults    []Rule
+	rules       map[string][]ruleSet
+	noDivisions bool
+}
+
+// Build fetches the conferences and the teams of year from src and returns
+// a builder over their membership.
+func Build(
+	ctx context.Context, src Source, year int32, opts ...Option,
+) (*Builder, error) {
+	conferences, err := src.GetConferences(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get conferences; %w", err)
+	}
+	teams, err := src.GetTeams(ctx, cfbd.GetTeamsRequest{Year: year})
+	if err != nil {
+		return nil, fmt.Errorf("could not get %d teams; %w", year, err)
+	}
+
+	opts = append([]Option{WithConferences(conferences)}, opts...)
+
+	return New(teams, opts...), nil
+}
+
+// New returns a builder over the conference and division membership of
+// teams.
+func New(teams []*cfbd.Team, opts ...Option) *Builder {
+	b := &Builder{
+		members:  make(map[string]member),
+		defaults: DefaultRules(),
+		rules:    make(map[string][]ruleSet),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	for _, t := range teams {
+		conf := t.GetConference()
+		if conf == "" || conf == Independents {
+			continue
+		}
+		if _, ok := b.conferences[conf]; b.conferences != nil && !ok {
+			continue
+		}
+		m := member{conference: conf, division: t.GetDivision()}
+		if b.noDivisions {
+			m.division = ""
+		}
+		b.members[t.GetSchool()] = m
+	}
+
+	return b
+}
+
+// Rules returns the tiebreaker rules of conference in season.
+func (b *Builder) Rules(conference string, season int32) []Rule {
+	names := b.conferences[conference]
+	if len(names) == 0 {
+		names = []string{conference}
+	}
+
+	var best *ruleSet
+	for _, name := range names {
+		for i, set := range b.rules[name] {
+			if set.season > season {
+				continue
+			}
+			if best == nil || set.season >= best.season {
+				best = &b.rules[name][i]
+			}
+		}
+	}
+	if best == nil {
+		return b.defaults
+	}
+
+	return best.rules
+}
+
+// Standings orders every conference, or every division of conferences
+// with divisions, by the completed games among games. Games between
+// members of the same conference count towards the conference record,
+// except conference championship games. The season is the latest season
+// among games. Tables are ordered by conference and division.
+func (b *Builder) Standings(games []*cfbd.Game) []Table {
+	var season int32
+	results := make(map[string][]result)
+	overall := make(map[string]Record)
+	for _, g := range games {
+		season = max(season, g.GetSeason())
+		if !completed(g) {
+			continue
+		}
+		b.addGame(g, results, overall)
+	}
+
+	groups := make(map[member][]string)
+	for team, m := range b.members {
+		groups[m] = append(groups[m], team)
+	}
+	keys := slices.SortedFunc(maps.Keys(groups), func(a, b member) int {
+		return cmp.Or(
+			cmp.Compare(a.conference, b.conference),
+			cmp.Compare(a.division, b.division),
+		)
+	})
+
+	// Divisions break ties against the conference's order of finish.
+	finish := make(map[string][][]string)
+	for _, m := range keys {
+		if m.division == "" {
+			continue
+		}
+		if _, ok := finish[m.conference]; ok {
+			continue
+		}
+		c := &Context{
+			Season:     season,
+			Conference: m.conference,
+			results:    results,
+			overall:    overall,
+		}
+		finish[m.conference] = finishOrder(table(c,
+			b.Rules(m.conference, season), conferenceTeams(groups, m)))
+	}
+
+	tables := make([]Table, 0, len(keys))
+	for _, m := range keys {
+		c := &Context{
+			Season:     season,
+			Conference: m.conference,
+			Division:   m.division,
+			results:    results,
+			overall:    overall,
+			finish:     finish[m.conference],
+		}
+		rules := b.Rules(m.conference, season)
+		tables = append(tables, table(c, rules, groups[m]))
+	}
+
+	return tables
+}
+
+// conferenceTeams returns the teams of every division of m's conference.
+func conferenceTeams(groups map[member][]string, m member) []string {
+	var out []string
+	for k, teams := range groups {
+		if k.conference == m.conference {
+			out = append(out, teams...)
+		}
+	}
+
+	return out
+}
+
+// finishOrder groups a table's standings in order of finish. Consecutive
+// teams left unresolved with the same conference record share a group.
+func finishOrder(t Table) [][]string {
+	var out [][]string
+	for i, s := range t.Standings {
+		if i > 0 && s.Tiebreaker == Unresolved &&
+			t.Standings[i-1].Tiebreaker == Unresolved &&
+			s.ConferenceRecord == t.Standings[i-1].ConferenceRecord {
+			last := len(out) - 1
+			out[last] = append(out[last], s.Team)
+			continue
+		}
+		out = append(out, []string{s.Team})
+	}
+
+	return out
+}
+
+func (b *Builder) addGame(
+	g *cfbd.Game, results map[string][]result, overall map[string]Record,
+) {
+	home, away := g.GetHomeTeam(), g.GetAwayTeam()
+	margin := g.GetHomePoints() - g.GetAwayPoints()
+
+	h, a := overall[home], overall[away]
+	h.add(margin)
+	a.add(-margin)
+	overall[home], overall[away] = h, a
+
+	hm, ok := b.members[home]
+	if !ok || hm.conference != b.members[away].conference {
+		return
+	}
+	if strings.Contains(strings.ToLower(g.GetNotes()), "championship") {
+		return
+	}
+	results[home] = append(results[home], result{away, margin})
+	results[away] = append(results[away], result{home, -margin})
+}
+
+func completed(g *cfbd.Game) bool {
+	return g.GetCompleted() && g.HomePoints != nil && g.AwayPoints != nil
+}
+
+// table orders teams by conference winning percentage and breaks ties
+// between them with rules.
+func table(c *Context, rules []Rule, teams []string) Table {
+	slices.Sort(teams)
+	c.order = Partition(teams, func(team string) float64 {
+		return c.ConferenceRecord(team).Pct()
+	})
+
+	t := Table{
+		Conference: c.Conference,
+		Division:   c.Division,
+		Season:     c.Season,
+	}
+	for _, group := range c.order {
+		resolve(c, rules, group, "", func(team, tiebreaker string) {
+			t.Standings = append(t.Standings, Standing{
+				Rank:             len(t.Standings) + 1,
+				Team:             team,
+				Conference:       c.Conference,
+				Division:         c.Division,
+				ConferenceRecord: c.ConferenceRecord(team),
+				Overall:          c.Overall(team),
+				Tiebreaker:       tiebreaker,
+			})
+		})
+	}
+
+	return t
+}
+
+// resolve places tied teams with the first rule that separates them. Each
+// group it splits them into starts over from the first rule, so a
+// multi-team tie that is reduced to fewer teams is broken as a tie among
+// those teams.
+func resolve(
+	c *Context, rules []Rule, tied []string, decided string,
+	place func(team, tiebreaker string),
+) {
+	if len(tied) == 1 {
+		place(tied[0], decided)
+		return
+	}
+
+	for _, r := range rules {
+		split := r.Split(c, tied)
+		if len(split) < 2 {
+			continue
+		}
+		for _, group := range split {
+			resolve(c, rules, group, r.Name(), place)
+		}
+		return
+	}
+
+	for _, team := range slices.Sorted(slices.Values(tied)) {
+		place(team, Unresolved)
+	}
+}
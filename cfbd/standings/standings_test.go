@@ -0,0 +1,237 @@
+package standings
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func teamIn(school, conference, division string) *cfbd.Team {
+	return &cfbd.Team{
+		School:     school,
+		Conference: conference,
+		Division:   division,
+	}
+}
+
+// beat is a completed 2025 game the home team won by a touchdown.
+func beat(home, away string) *cfbd.Game {
+	homePts, awayPts := int32(21), int32(14)
+	return &cfbd.Game{
+		Season:     2025,
+		HomeTeam:   home,
+		AwayTeam:   away,
+		Completed:  true,
+		HomePoints: &homePts,
+		AwayPoints: &awayPts,
+	}
+}
+
+func east() []*cfbd.Team {
+	return []*cfbd.Team{
+		teamIn("A", "East", ""),
+		teamIn("B", "East", ""),
+		teamIn("C", "East", ""),
+		teamIn("D", "East", ""),
+		teamIn("X", Independents, ""),
+	}
+}
+
+// circle leaves A, B and C tied at 2-1 after beating each other in a
+// circle and all beating D.
+func circle() []*cfbd.Game {
+	return []*cfbd.Game{
+		beat("A", "B"), beat("B", "C"), beat("C", "A"),
+		beat("A", "D"), beat("B", "D"), beat("C", "D"),
+	}
+}
+
+func teams(t Table) []string {
+	var out []string
+	for _, s := range t.Standings {
+		out = append(out, s.Team)
+	}
+
+	return out
+}
+
+func tiebreakers(t Table) []string {
+	var out []string
+	for _, s := range t.Standings {
+		out = append(out, s.Tiebreaker)
+	}
+
+	return out
+}
+
+func TestStandings_CircularTie_ShouldFallToOverallRecord(t *testing.T) {
+	games := append(circle(), beat("A", "X"), beat("X", "B"))
+
+	tables := New(east()).Standings(games)
+
+	require.Len(t, tables, 1)
+	table := tables[0]
+	assert.Equal(t, "East", table.Conference)
+	assert.Equal(t, int32(2025), table.Season)
+	assert.Equal(t, []string{"A", "C", "B", "D"}, teams(table))
+	assert.Equal(t, []string{
+		"overall record", "overall record", "overall record", "",
+	}, tiebreakers(table))
+	assert.Equal(t, Record{Wins: 2, Losses: 1}, table.Standings[0].
+		ConferenceRecord)
+	assert.Equal(t, Record{Wins: 3, Losses: 1}, table.Standings[0].Overall)
+	assert.Equal(t, 4, table.Standings[3].Rank)
+}
+
+func TestStandings_MultiTeamTie_ShouldRestartWithRemainingTeams(
+	t *testing.T,
+) {
+	cfp := Ranking("CFP ranking", map[string]int{"C": 4})
+	b := New(east(), WithDefaultRules(HeadToHead(), cfp))
+
+	table := b.Standings(circle())[0]
+
+	// C is separated by ranking, then A beat B head-to-head.
+	assert.Equal(t, []string{"C", "A", "B", "D"}, teams(table))
+	assert.Equal(t, []string{
+		"CFP ranking", "head-to-head", "head-to-head", "",
+	}, tiebreakers(table))
+}
+
+func TestStandings_NoRuleSeparates_ShouldOrderByName(t *testing.T) {
+	b := New(east(), WithDefaultRules(HeadToHead()))
+
+	table := b.Standings(circle())[0]
+
+	assert.Equal(t, []string{"A", "B", "C", "D"}, teams(table))
+	assert.Equal(t, Unresolved, table.Standings[0].Tiebreaker)
+}
+
+func TestStandings_TitleGameAndUnplayed_ShouldNotCount(t *testing.T) {
+	title := beat("B", "A")
+	title.Notes = "East Championship"
+	unplayed := beat("C", "A")
+	unplayed.Completed = false
+
+	table := New(east()).Standings(
+		[]*cfbd.Game{beat("A", "B"), title, unplayed})[0]
+
+	assert.Equal(t, "A", table.Standings[0].Team)
+	assert.Equal(t, Record{Wins: 1}, table.Standings[0].ConferenceRecord)
+	assert.Equal(t, Record{Wins: 1, Losses: 1}, table.Standings[0].Overall)
+}
+
+func TestStandings_Divisions_ShouldSplitTables(t *testing.T) {
+	members := []*cfbd.Team{
+		teamIn("A", "East", "North"),
+		teamIn("B", "East", "North"),
+		teamIn("C", "East", "South"),
+	}
+	games := []*cfbd.Game{beat("B", "A"), beat("A", "C")}
+
+	tables := New(members).Standings(games)
+	whole := New(members, WithoutDivisions()).Standings(games)
+
+	require.Len(t, tables, 2)
+	assert.Equal(t, "North", tables[0].Division)
+	assert.Equal(t, []string{"B", "A"}, teams(tables[0]))
+	assert.Equal(t, []string{"C"}, teams(tables[1]))
+	require.Len(t, whole, 1)
+	assert.Equal(t, []string{"B", "A", "C"}, teams(whole[0]))
+}
+
+func TestStandings_Divisions_ShouldUseConferenceFinish(t *testing.T) {
+	members := []*cfbd.Team{
+		teamIn("A", "East", "North"),
+		teamIn("B", "East", "North"),
+		teamIn("C", "East", "South"),
+		teamIn("D", "East", "South"),
+		teamIn("X", Independents, ""),
+	}
+	// Everyone is 1-1 in conference. C finishes first on overall record,
+	// so B's win over C breaks the North tie, which A and B's shared 1-1
+	// against C and D could not.
+	games := []*cfbd.Game{
+		beat("C", "A"), beat("A", "D"), beat("B", "C"), beat("D", "B"),
+		beat("C", "X"),
+	}
+
+	tables := New(members).Standings(games)
+
+	require.Len(t, tables, 2)
+	assert.Equal(t, []string{"B", "A"}, teams(tables[0]))
+	assert.Equal(t, "common opponents in order of finish",
+		tables[0].Standings[0].Tiebreaker)
+	assert.Equal(t, []string{"C", "D"}, teams(tables[1]))
+}
+
+func TestRules_BySeasonAndAlias_ShouldPickLatestApplicable(t *testing.T) {
+	conferences := fixture.Load(t, "conferences.json",
+		func() *cfbd.Conference { return &cfbd.Conference{} })
+	old := []Rule{OverallRecord()}
+	current := []Rule{HeadToHead()}
+	b := New(nil,
+		WithConferences(conferences),
+		WithRules("MAC", 2020, old...),
+		WithRules("Mid-American", 2024, current...),
+	)
+
+	assert.Equal(t, DefaultRules()[0].Name(),
+		b.Rules("Mid-American", 2019)[0].Name())
+	assert.Equal(t, "overall record", b.Rules("Mid-American", 2023)[0].Name())
+	assert.Equal(t, "head-to-head", b.Rules("Mid-American", 2025)[0].Name())
+}
+
+func TestNew_WithConferences_ShouldSkipOthers(t *testing.T) {
+	conferences := []*cfbd.Conference{{Name: "East"}}
+	members := append(east(), teamIn("E", "West", ""))
+
+	tables := New(members, WithConferences(conferences)).Standings(nil)
+
+	require.Len(t, tables, 1)
+	assert.Equal(t, "East", tables[0].Conference)
+}
+
+type fakeSource struct {
+	teams       []*cfbd.Team
+	conferences []*cfbd.Conference
+	err         error
+	got         cfbd.GetTeamsRequest
+}
+
+func (f *fakeSource) GetTeams(
+	_ context.Context, request cfbd.GetTeamsRequest,
+) ([]*cfbd.Team, error) {
+	f.got = request
+	return f.teams, f.err
+}
+
+func (f *fakeSource) GetConferences(
+	context.Context,
+) ([]*cfbd.Conference, error) {
+	return f.conferences, f.err
+}
+
+func TestBuild_ShouldFetchMembership(t *testing.T) {
+	src := &fakeSource{
+		teams:       east(),
+		conferences: []*cfbd.Conference{{Name: "East", Abbreviation: "E"}},
+	}
+
+	b, err := Build(context.Background(), src, 2025,
+		WithRules("E", 0, OverallRecord()))
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2025), src.got.Year)
+	assert.Equal(t, "overall record", b.Rules("East", 2025)[0].Name())
+	assert.Len(t, b.Standings(circle())[0].Standings, 4)
+
+	boom := errors.New("boom")
+	_, err = Build(context.Background(), &fakeSource{err: boom}, 2025)
+	assert.ErrorIs(t, err, boom)
+}
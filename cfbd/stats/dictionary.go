@@ -0,0 +1,104 @@
+package stats
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// opponentSuffix marks season stats describing a team's opponents, e.g.
+// rushingYardsOpponent. They share the kind of the unsuffixed stat.
+const opponentSuffix = "Opponent"
+
+// teamKinds maps team stat names, as returned by GetStatCategories and used
+// by GetGameTeams and GetTeamSeasonStats, to their kind. Names missing from
+// the dictionary are counts.
+var teamKinds = map[string]Kind{
+	// Listed by GetStatCategories.
+	"completionAttempts":  KindMadeAttempts,
+	"defensiveTDs":        KindCount,
+	"extraPoints":         KindCount,
+	"fieldGoalPct":        KindPercent,
+	"fieldGoals":          KindCount,
+	"firstDowns":          KindCount,
+	"fourthDownEff":       KindMadeAttempts,
+	"fumblesLost":         KindCount,
+	"fumblesRecovered":    KindCount,
+	"interceptions":       KindCount,
+	"interceptionTDs":     KindCount,
+	"interceptionYards":   KindCount,
+	"kickingPoints":       KindCount,
+	"kickReturns":         KindCount,
+	"kickReturnTDs":       KindCount,
+	"kickReturnYards":     KindCount,
+	"netPassingYards":     KindCount,
+	"passesDeflected":     KindCount,
+	"passesIntercepted":   KindCount,
+	"passingTDs":          KindCount,
+	"possessionTime":      KindDuration,
+	"puntReturns":         KindCount,
+	"puntReturnTDs":       KindCount,
+	"puntReturnYards":     KindCount,
+	"qbHurries":           KindCount,
+	"rushingAttempts":     KindCount,
+	"rushingTDs":          KindCount,
+	"rushingYards":        KindCount,
+	"sacks":               KindCount,
+	"tackles":             KindCount,
+	"tacklesForLoss":      KindCount,
+	"thirdDownEff":        KindMadeAttempts,
+	"totalFumbles":        KindCount,
+	"totalPenaltiesYards": KindCountYards,
+	"totalYards":          KindCount,
+	"turnovers":           KindCount,
+	"yardsPerPass":        KindDecimal,
+	"yardsPerRushAttempt": KindDecimal,
+	// Season-only stats.
+	"fourthDownConversions": KindCount,
+	"fourthDowns":           KindCount,
+	"games":                 KindCount,
+	"passAttempts":          KindCount,
+	"passCompletions":       KindCount,
+	"penalties":             KindCount,
+	"penaltyYards":          KindCount,
+	"thirdDownConversions":  KindCount,
+	"thirdDowns":            KindCount,
+}
+
+// playerKinds maps player stat types, as used by GetPlayerSeasonStats and
+// GetGamePlayers, to their kind. Types missing from the dictionary are
+// counts.
+var playerKinds = map[string]Kind{
+	"C/ATT": KindMadeAttempts,
+	"FG":    KindMadeAttempts,
+	"XP":    KindMadeAttempts,
+	"AVG":   KindDecimal,
+	"QBR":   KindDecimal,
+	"YPA":   KindDecimal,
+	"YPC":   KindDecimal,
+	"YPR":   KindDecimal,
+	"PCT":   KindPercent,
+}
+
+// TeamKind returns the kind of a team stat name. Opponent variants such as
+// possessionTimeOpponent share the kind of the base stat.
+func TeamKind(name string) (Kind, bool) {
+	k, ok := teamKinds[name]
+	if !ok {
+		k, ok = teamKinds[strings.TrimSuffix(name, opponentSuffix)]
+	}
+
+	return k, ok
+}
+
+// PlayerKind returns the kind of a player stat type such as "C/ATT".
+func PlayerKind(statType string) (Kind, bool) {
+	k, ok := playerKinds[strings.ToUpper(statType)]
+
+	return k, ok
+}
+
+// TeamCategories lists the team stat names in the dictionary, sorted.
+func TeamCategories() []string {
+	return slices.Sorted(maps.Keys(teamKinds))
+}
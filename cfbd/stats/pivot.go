@@ -0,0 +1,595 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// Passing is a passing stat line.
+type Passing struct {
+	Completions     int32
+	Attempts        int32
+	Yards           int32
+	Touchdowns      int32
+	Interceptions   int32
+	YardsPerAttempt float64
+	// CompletionPct is a fraction between 0 and 1.
+	CompletionPct float64
+	QBR           float64
+}
+
+// Rushing is a rushing stat line.
+type Rushing struct {
+	Carries       int32
+	Yards         int32
+	Touchdowns    int32
+	Long          int32
+	YardsPerCarry float64
+}
+
+// Receiving is a receiving stat line.
+type Receiving struct {
+	Receptions        int32
+	Yards             int32
+	Touchdowns        int32
+	Long              int32
+	YardsPerReception float64
+}
+
+// Defensive is a defensive stat line. Sacks and tackles for loss are
+// fractional because shared plays are split.
+type Defensive struct {
+	Tackles        int32
+	Solo           int32
+	PassesDefended int32
+	QBHurries      int32
+	Touchdowns     int32
+	Sacks          float64
+	TacklesForLoss float64
+}
+
+// Interceptions is an interception return stat line.
+type Interceptions struct {
+	Interceptions int32
+	Yards         int32
+	Touchdowns    int32
+}
+
+// Fumbles is a fumble stat line.
+type Fumbles struct {
+	Fumbles   int32
+	Lost      int32
+	Recovered int32
+}
+
+// Kicking is a place kicking stat line.
+type Kicking struct {
+	FieldGoalsMade     int32
+	FieldGoalAttempts  int32
+	ExtraPointsMade    int32
+	ExtraPointAttempts int32
+	Long               int32
+	Points             int32
+	// FieldGoalPct is a fraction between 0 and 1.
+	FieldGoalPct float64
+}
+
+// Punting is a punting stat line.
+type Punting struct {
+	Punts      int32
+	Yards      int32
+	Touchbacks int32
+	Inside20   int32
+	Long       int32
+	Average    float64
+}
+
+// Returns is a kick or punt return stat line.
+type Returns struct {
+	Returns    int32
+	Yards      int32
+	Long       int32
+	Touchdowns int32
+	Average    float64
+}
+
+// PlayerLine is one player's stats pivoted into a row per player. Category
+// lines are nil when the player has no stats in that category.
+type PlayerLine struct {
+	PlayerID string
+	Player   string
+	Position string
+	Team     string
+	// Conference and Season are only set by PivotPlayerStats.
+	Conference string
+	Season     int32
+	// GameID is only set by PivotGamePlayerStats.
+	GameID int32
+
+	Passing       *Passing
+	Rushing       *Rushing
+	Receiving     *Receiving
+	Defensive     *Defensive
+	Interceptions *Interceptions
+	Fumbles       *Fumbles
+	Kicking       *Kicking
+	Punting       *Punting
+	KickReturns   *Returns
+	PuntReturns   *Returns
+
+	// Extras holds stats without a typed field, keyed by
+	// "category.STAT_TYPE", e.g. "passing.SACKS".
+	Extras map[string]Value
+}
+
+// TeamLine is one team's stats pivoted into a row per team.
+type TeamLine struct {
+	Team       string
+	Conference string
+	// Season is only set by PivotTeamStats.
+	Season int32
+	// GameID and HomeAway are only set by PivotGameTeamStats.
+	GameID   int32
+	HomeAway string
+
+	Passing       *Passing
+	Rushing       *Rushing
+	Defensive     *Defensive
+	Interceptions *Interceptions
+	Fumbles       *Fumbles
+	Kicking       *Kicking
+	KickReturns   *Returns
+	PuntReturns   *Returns
+
+	// Extras holds stats without a typed field, such as firstDowns,
+	// possessionTime and every opponent stat, keyed by stat name.
+	Extras map[string]Value
+}
+
+// ensure allocates *p on first use.
+func ensure[T any](p **T) *T {
+	if *p == nil {
+		*p = new(T)
+	}
+
+	return *p
+}
+
+func whole(v Value) int32 {
+	return int32(math.Round(v.Float()))
+}
+
+type playerSetter func(l *PlayerLine, v Value)
+
+func returnSetters(line func(l *PlayerLine) **Returns) map[string]playerSetter {
+	return map[string]playerSetter{
+		"NO":   func(l *PlayerLine, v Value) { ensure(line(l)).Returns = whole(v) },
+		"YDS":  func(l *PlayerLine, v Value) { ensure(line(l)).Yards = whole(v) },
+		"AVG":  func(l *PlayerLine, v Value) { ensure(line(l)).Average = v.Float() },
+		"LONG": func(l *PlayerLine, v Value) { ensure(line(l)).Long = whole(v) },
+		"TD": func(l *PlayerLine, v Value) {
+			ensure(line(l)).Touchdowns = whole(v)
+		},
+	}
+}
+
+// playerSetters maps category and upper-cased stat type to the PlayerLine
+// field it fills. Season stats and box scores use different abbreviations
+// for some stats, e.g. YPA and AVG.
+var playerSetters = map[string]map[string]playerSetter{
+	"passing": {
+		"C/ATT": func(l *PlayerLine, v Value) {
+			p := ensure(&l.Passing)
+			p.Completions, p.Attempts = v.Made, v.Attempts
+		},
+		"COMPLETIONS": func(l *PlayerLine, v Value) {
+			ensure(&l.Passing).Completions = whole(v)
+		},
+		"ATT": func(l *PlayerLine, v Value) {
+			ensure(&l.Passing).Attempts = whole(v)
+		},
+		"YDS": func(l *PlayerLine, v Value) { ensure(&l.Passing).Yards = whole(v) },
+		"TD": func(l *PlayerLine, v Value) {
+			ensure(&l.Passing).Touchdowns = whole(v)
+		},
+		"INT": func(l *PlayerLine, v Value) {
+			ensure(&l.Passing).Interceptions = whole(v)
+		},
+		"AVG": func(l *PlayerLine, v Value) {
+			ensure(&l.Passing).YardsPerAttempt = v.Float()
+		},
+		"YPA": func(l *PlayerLine, v Value) {
+			ensure(&l.Passing).YardsPerAttempt = v.Float()
+		},
+		"PCT": func(l *PlayerLine, v Value) {
+			ensure(&l.Passing).CompletionPct = v.Fraction()
+		},
+		"QBR": func(l *PlayerLine, v Value) { ensure(&l.Passing).QBR = v.Float() },
+	},
+	"rushing": {
+		"CAR": func(l *PlayerLine, v Value) {
+			ensure(&l.Rushing).Carries = whole(v)
+		},
+		"YDS": func(l *PlayerLine, v Value) { ensure(&l.Rushing).Yards = whole(v) },
+		"TD": func(l *PlayerLine, v Value) {
+			ensure(&l.Rushing).Touchdowns = whole(v)
+		},
+		"LONG": func(l *PlayerLine, v Value) { ensure(&l.Rushing).Long = whole(v) },
+		"AVG": func(l *PlayerLine, v Value) {
+			ensure(&l.Rushing).YardsPerCarry = v.Float()
+		},
+		"YPC": func(l *PlayerLine, v Value) {
+			ensure(&l.Rushing).YardsPerCarry = v.Float()
+		},
+	},
+	"receiving": {
+		"REC": func(l *PlayerLine, v Value) {
+			ensure(&l.Receiving).Receptions = whole(v)
+		},
+		"YDS": func(l *PlayerLine, v Value) { ensure(&l.Receiving).Yards = whole(v) },
+		"TD": func(l *PlayerLine, v Value) {
+			ensure(&l.Receiving).Touchdowns = whole(v)
+		},
+		"LONG": func(l *PlayerLine, v Value) { ensure(&l.Receiving).Long = whole(v) },
+		"AVG": func(l *PlayerLine, v Value) {
+			ensure(&l.Receiving).YardsPerReception = v.Float()
+		},
+		"YPR": func(l *PlayerLine, v Value) {
+			ensure(&l.Receiving).YardsPerReception = v.Float()
+		},
+	},
+	"defensive": {
+		"TOT": func(l *PlayerLine, v Value) {
+			ensure(&l.Defensive).Tackles = whole(v)
+		},
+		"SOLO": func(l *PlayerLine, v Value) { ensure(&l.Defensive).Solo = whole(v) },
+		"PD": func(l *PlayerLine, v Value) {
+			ensure(&l.Defensive).PassesDefended = whole(v)
+		},
+		"QB HUR": func(l *PlayerLine, v Value) {
+			ensure(&l.Defensive).QBHurries = whole(v)
+		},
+		"TD": func(l *PlayerLine, v Value) {
+			ensure(&l.Defensive).Touchdowns = whole(v)
+		},
+		"SACKS": func(l *PlayerLine, v Value) {
+			ensure(&l.Defensive).Sacks = v.Float()
+		},
+		"TFL": func(l *PlayerLine, v Value) {
+			ensure(&l.Defensive).TacklesForLoss = v.Float()
+		},
+	},
+	"interceptions": {
+		"INT": func(l *PlayerLine, v Value) {
+			ensure(&l.Interceptions).Interceptions = whole(v)
+		},
+		"YDS": func(l *PlayerLine, v Value) {
+			ensure(&l.Interceptions).Yards = whole(v)
+		},
+		"TD": func(l *PlayerLine, v Value) {
+			ensure(&l.Interceptions).Touchdowns = whole(v)
+		},
+	},
+	"fumbles": {
+		"FUM": func(l *PlayerLine, v Value) {
+			ensure(&l.Fumbles).Fumbles = whole(v)
+		},
+		"LOST": func(l *PlayerLine, v Value) { ensure(&l.Fumbles).Lost = whole(v) },
+		"REC": func(l *PlayerLine, v Value) {
+			ensure(&l.Fumbles).Recovered = whole(v)
+		},
+	},
+	"kicking": {
+		"FG": func(l *PlayerLine, v Value) {
+			k := ensure(&l.Kicking)
+			k.FieldGoalsMade, k.FieldGoalAttempts = v.Made, v.Attempts
+		},
+		"FGM": func(l *PlayerLine, v Value) {
+			ensure(&l.Kicking).FieldGoalsMade = whole(v)
+		},
+		"FGA": func(l *PlayerLine, v Value) {
+			ensure(&l.Kicking).FieldGoalAttempts = whole(v)
+		},
+		"XP": func(l *PlayerLine, v Value) {
+			k := ensure(&l.Kicking)
+			k.ExtraPointsMade, k.ExtraPointAttempts = v.Made, v.Attempts
+		},
+		"XPM": func(l *PlayerLine, v Value) {
+			ensure(&l.Kicking).ExtraPointsMade = whole(v)
+		},
+		"XPA": func(l *PlayerLine, v Value) {
+			ensure(&l.Kicking).ExtraPointAttempts = whole(v)
+		},
+		"PCT": func(l *PlayerLine, v Value) {
+			ensure(&l.Kicking).FieldGoalPct = v.Fraction()
+		},
+		"LONG": func(l *PlayerLine, v Value) { ensure(&l.Kicking).Long = whole(v) },
+		"PTS":  func(l *PlayerLine, v Value) { ensure(&l.Kicking).Points = whole(v) },
+	},
+	"punting": {
+		"NO":  func(l *PlayerLine, v Value) { ensure(&l.Punting).Punts = whole(v) },
+		"YDS": func(l *PlayerLine, v Value) { ensure(&l.Punting).Yards = whole(v) },
+		"TB": func(l *PlayerLine, v Value) {
+			ensure(&l.Punting).Touchbacks = whole(v)
+		},
+		"IN 20": func(l *PlayerLine, v Value) {
+			ensure(&l.Punting).Inside20 = whole(v)
+		},
+		"LONG": func(l *PlayerLine, v Value) { ensure(&l.Punting).Long = whole(v) },
+		"AVG": func(l *PlayerLine, v Value) {
+			ensure(&l.Punting).Average = v.Float()
+		},
+		"YPP": func(l *PlayerLine, v Value) {
+			ensure(&l.Punting).Average = v.Float()
+		},
+	},
+	"kickReturns": returnSetters(func(l *PlayerLine) **Returns {
+		return &l.KickReturns
+	}),
+	"puntReturns": returnSetters(func(l *PlayerLine) **Returns {
+		return &l.PuntReturns
+	}),
+}
+
+func (l *PlayerLine) set(category, statType string, v Value) {
+	statType = strings.ToUpper(statType)
+	if set, ok := playerSetters[category][statType]; ok {
+		set(l, v)
+		return
+	}
+
+	if l.Extras == nil {
+		l.Extras = make(map[string]Value)
+	}
+	l.Extras[category+"."+statType] = v
+}
+
+// PivotPlayerStats pivots season stats from GetPlayerSeasonStats into one
+// line per player, keyed by player ID.
+func PivotPlayerStats(
+	stats []*cfbd.PlayerStat,
+) (map[string]*PlayerLine, error) {
+	out := make(map[string]*PlayerLine)
+	for _, s := range stats {
+		v, err := PlayerStat(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s; %w", s.GetPlayer(), s.GetCategory(), err)
+		}
+
+		line, ok := out[s.GetPlayerId()]
+		if !ok {
+			line = &PlayerLine{
+				PlayerID:   s.GetPlayerId(),
+				Player:     s.GetPlayer(),
+				Position:   s.GetPosition(),
+				Team:       s.GetTeam(),
+				Conference: s.GetConference(),
+				Season:     s.GetSeason(),
+			}
+			out[s.GetPlayerId()] = line
+		}
+		line.set(s.GetCategory(), s.GetStatType(), v)
+	}
+
+	return out, nil
+}
+
+// PivotGamePlayerStats pivots box scores from GetGamePlayers into one line
+// per player per game, keyed by game ID and then player ID.
+func PivotGamePlayerStats(
+	games []*cfbd.GamePlayerStats,
+) (map[int32]map[string]*PlayerLine, error) {
+	out := make(map[int32]map[string]*PlayerLine, len(games))
+	for _, game := range games {
+		lines := make(map[string]*PlayerLine)
+		out[game.GetId()] = lines
+		for _, team := range game.GetTeams() {
+			for _, category := range team.GetCategories() {
+				for _, statType := range category.GetTypes() {
+					for _, player := range statType.GetAthletes() {
+						v, err := GamePlayerStat(statType, player)
+						if err != nil {
+							return nil, fmt.Errorf("game %d %s %s; %w",
+								game.GetId(), player.GetName(), category.GetName(), err)
+						}
+
+						line, ok := lines[player.GetId()]
+						if !ok {
+							line = &PlayerLine{
+								PlayerID: player.GetId(),
+								Player:   player.GetName(),
+								Team:     team.GetTeam(),
+								GameID:   game.GetId(),
+							}
+							lines[player.GetId()] = line
+						}
+						line.set(category.GetName(), statType.GetName(), v)
+					}
+				}
+			}
+		}
+	}
+
+	return out, nil
+}
+
+type teamSetter func(l *TeamLine, v Value)
+
+// teamSetters maps team stat names to the TeamLine field they fill. Game
+// box scores report completions and attempts as one completionAttempts
+// pair; season stats report them separately. In both, interceptions counts
+// the passes the team threw that were intercepted and passesIntercepted
+// the interceptions its defense made.
+var teamSetters = map[string]teamSetter{
+	"completionAttempts": func(l *TeamLine, v Value) {
+		p := ensure(&l.Passing)
+		p.Completions, p.Attempts = v.Made, v.Attempts
+	},
+	"passCompletions": func(l *TeamLine, v Value) {
+		ensure(&l.Passing).Completions = whole(v)
+	},
+	"passAttempts": func(l *TeamLine, v Value) {
+		ensure(&l.Passing).Attempts = whole(v)
+	},
+	"netPassingYards": func(l *TeamLine, v Value) {
+		ensure(&l.Passing).Yards = whole(v)
+	},
+	"passingTDs": func(l *TeamLine, v Value) {
+		ensure(&l.Passing).Touchdowns = whole(v)
+	},
+	"interceptions": func(l *TeamLine, v Value) {
+		ensure(&l.Passing).Interceptions = whole(v)
+	},
+	"yardsPerPass": func(l *TeamLine, v Value) {
+		ensure(&l.Passing).YardsPerAttempt = v.Float()
+	},
+	"rushingAttempts": func(l *TeamLine, v Value) {
+		ensure(&l.Rushing).Carries = whole(v)
+	},
+	"rushingYards": func(l *TeamLine, v Value) {
+		ensure(&l.Rushing).Yards = whole(v)
+	},
+	"rushingTDs": func(l *TeamLine, v Value) {
+		ensure(&l.Rushing).Touchdowns = whole(v)
+	},
+	"yardsPerRushAttempt": func(l *TeamLine, v Value) {
+		ensure(&l.Rushing).YardsPerCarry = v.Float()
+	},
+	"tackles": func(l *TeamLine, v Value) {
+		ensure(&l.Defensive).Tackles = whole(v)
+	},
+	"sacks": func(l *TeamLine, v Value) {
+		ensure(&l.Defensive).Sacks = v.Float()
+	},
+	"tacklesForLoss": func(l *TeamLine, v Value) {
+		ensure(&l.Defensive).TacklesForLoss = v.Float()
+	},
+	"qbHurries": func(l *TeamLine, v Value) {
+		ensure(&l.Defensive).QBHurries = whole(v)
+	},
+	"passesDeflected": func(l *TeamLine, v Value) {
+		ensure(&l.Defensive).PassesDefended = whole(v)
+	},
+	"defensiveTDs": func(l *TeamLine, v Value) {
+		ensure(&l.Defensive).Touchdowns = whole(v)
+	},
+	"passesIntercepted": func(l *TeamLine, v Value) {
+		ensure(&l.Interceptions).Interceptions = whole(v)
+	},
+	"interceptionYards": func(l *TeamLine, v Value) {
+		ensure(&l.Interceptions).Yards = whole(v)
+	},
+	"interceptionTDs": func(l *TeamLine, v Value) {
+		ensure(&l.Interceptions).Touchdowns = whole(v)
+	},
+	"totalFumbles": func(l *TeamLine, v Value) {
+		ensure(&l.Fumbles).Fumbles = whole(v)
+	},
+	"fumblesLost": func(l *TeamLine, v Value) {
+		ensure(&l.Fumbles).Lost = whole(v)
+	},
+	"fumblesRecovered": func(l *TeamLine, v Value) {
+		ensure(&l.Fumbles).Recovered = whole(v)
+	},
+	"fieldGoals": func(l *TeamLine, v Value) {
+		ensure(&l.Kicking).FieldGoalsMade = whole(v)
+	},
+	"fieldGoalPct": func(l *TeamLine, v Value) {
+		ensure(&l.Kicking).FieldGoalPct = v.Fraction()
+	},
+	"extraPoints": func(l *TeamLine, v Value) {
+		ensure(&l.Kicking).ExtraPointsMade = whole(v)
+	},
+	"kickingPoints": func(l *TeamLine, v Value) {
+		ensure(&l.Kicking).Points = whole(v)
+	},
+	"kickReturns": func(l *TeamLine, v Value) {
+		ensure(&l.KickReturns).Returns = whole(v)
+	},
+	"kickReturnYards": func(l *TeamLine, v Value) {
+		ensure(&l.KickReturns).Yards = whole(v)
+	},
+	"kickReturnTDs": func(l *TeamLine, v Value) {
+		ensure(&l.KickReturns).Touchdowns = whole(v)
+	},
+	"puntReturns": func(l *TeamLine, v Value) {
+		ensure(&l.PuntReturns).Returns = whole(v)
+	},
+	"puntReturnYards": func(l *TeamLine, v Value) {
+		ensure(&l.PuntReturns).Yards = whole(v)
+	},
+	"puntReturnTDs": func(l *TeamLine, v Value) {
+		ensure(&l.PuntReturns).Touchdowns = whole(v)
+	},
+}
+
+func (l *TeamLine) set(name string, v Value) {
+	if set, ok := teamSetters[name]; ok {
+		set(l, v)
+		return
+	}
+
+	if l.Extras == nil {
+		l.Extras = make(map[string]Value)
+	}
+	l.Extras[name] = v
+}
+
+// PivotTeamStats pivots season stats from GetTeamSeasonStats into one line
+// per team, keyed by team name.
+func PivotTeamStats(stats []*cfbd.TeamStat) (map[string]*TeamLine, error) {
+	out := make(map[string]*TeamLine)
+	for _, s := range stats {
+		v, err := TeamStat(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s; %w", s.GetTeam(), err)
+		}
+
+		line, ok := out[s.GetTeam()]
+		if !ok {
+			line = &TeamLine{
+				Team:       s.GetTeam(),
+				Conference: s.GetConference(),
+				Season:     s.GetSeason(),
+			}
+			out[s.GetTeam()] = line
+		}
+		line.set(s.GetStatName(), v)
+	}
+
+	return out, nil
+}
+
+// PivotGameTeamStats pivots box scores from GetGameTeams into one line per
+// team per game, keyed by game ID and then team name.
+func PivotGameTeamStats(
+	games []*cfbd.GameTeamStats,
+) (map[int32]map[string]*TeamLine, error) {
+	out := make(map[int32]map[string]*TeamLine, len(games))
+	for _, game := range games {
+		lines := make(map[string]*TeamLine, len(game.GetTeams()))
+		out[game.GetId()] = lines
+		for _, team := range game.GetTeams() {
+			line := &TeamLine{
+				Team:       team.GetTeam(),
+				Conference: team.GetConference(),
+				GameID:     game.GetId(),
+				HomeAway:   team.GetHomeAway(),
+			}
+			for _, s := range team.GetStats() {
+				v, err := GameTeamStat(s)
+				if err != nil {
+					return nil, fmt.Errorf("game %d %s; %w",
+						game.GetId(), team.GetTeam(), err)
+				}
+				line.set(s.GetCategory(), v)
+			}
+			lines[team.GetTeam()] = line
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,151 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPivotGamePlayerStats_Fixture_ShouldFillTypedLines(t *testing.T) {
+	games := fixture.Load(t, "games_players.json",
+		func() *cfbd.GamePlayerStats { return &cfbd.GamePlayerStats{} })
+	require.NotEmpty(t, games)
+
+	lines, err := PivotGamePlayerStats(games)
+	require.NoError(t, err)
+
+	game := lines[games[0].GetId()]
+	require.NotNil(t, game)
+
+	qb := game["4870906"]
+	require.NotNil(t, qb)
+	assert.Equal(t, "Arch Manning", qb.Player)
+	assert.Equal(t, "Texas", qb.Team)
+	assert.Equal(t, games[0].GetId(), qb.GameID)
+	require.NotNil(t, qb.Passing)
+	assert.Equal(t, int32(18), qb.Passing.Completions)
+	assert.Equal(t, int32(21), qb.Passing.Attempts)
+	assert.Equal(t, int32(309), qb.Passing.Yards)
+	assert.Equal(t, int32(3), qb.Passing.Touchdowns)
+	assert.Equal(t, 14.7, qb.Passing.YardsPerAttempt)
+	assert.Equal(t, 81.6, qb.Passing.QBR)
+	assert.Nil(t, qb.Punting)
+
+	returner := game["4899367"]
+	require.NotNil(t, returner)
+	require.NotNil(t, returner.KickReturns)
+	require.NotNil(t, returner.PuntReturns)
+	assert.Equal(t, int32(22), returner.KickReturns.Yards)
+	assert.Equal(t, int32(3), returner.PuntReturns.Returns)
+	assert.Equal(t, int32(49), returner.PuntReturns.Long)
+
+	kicker := game["4879682"]
+	require.NotNil(t, kicker)
+	require.NotNil(t, kicker.Kicking)
+	assert.Equal(t, int32(2), kicker.Kicking.FieldGoalsMade)
+	assert.Equal(t, int32(2), kicker.Kicking.FieldGoalAttempts)
+	assert.InDelta(t, 1.0, kicker.Kicking.FieldGoalPct, 1e-9)
+	assert.Equal(t, int32(38), kicker.Kicking.Long)
+
+	for _, line := range game {
+		assert.Empty(t, line.Extras, line.Player)
+	}
+}
+
+func TestPivotPlayerStats_Fixture_ShouldKeyByPlayerID(t *testing.T) {
+	stats := fixture.Load(t, "stat_player_season.json",
+		func() *cfbd.PlayerStat { return &cfbd.PlayerStat{} })
+
+	lines, err := PivotPlayerStats(stats)
+	require.NoError(t, err)
+
+	require.Len(t, lines, 1)
+	qb := lines["4870906"]
+	require.NotNil(t, qb)
+	assert.Equal(t, "QB", qb.Position)
+	assert.Equal(t, "SEC", qb.Conference)
+	assert.Equal(t, int32(2025), qb.Season)
+	require.NotNil(t, qb.Passing)
+	assert.Equal(t, int32(17), qb.Passing.Completions)
+	assert.Equal(t, int32(30), qb.Passing.Attempts)
+	assert.Equal(t, int32(1), qb.Passing.Interceptions)
+	assert.InDelta(t, 0.567, qb.Passing.CompletionPct, 1e-9)
+	assert.Nil(t, qb.Rushing)
+}
+
+func TestPivotPlayerStats_UnknownType_ShouldLandInExtras(t *testing.T) {
+	lines, err := PivotPlayerStats([]*cfbd.PlayerStat{
+		{PlayerId: "1", Category: "passing", StatType: "SACKS", Stat: "2"},
+		{PlayerId: "1", Category: "blocking", StatType: "PANCAKES", Stat: "7"},
+	})
+	require.NoError(t, err)
+
+	line := lines["1"]
+	require.NotNil(t, line)
+	assert.Nil(t, line.Passing)
+	assert.Equal(t, 2.0, line.Extras["passing.SACKS"].Number)
+	assert.Equal(t, 7.0, line.Extras["blocking.PANCAKES"].Number)
+}
+
+func TestPivotPlayerStats_Unparseable_ShouldNamePlayer(t *testing.T) {
+	_, err := PivotPlayerStats([]*cfbd.PlayerStat{
+		{Player: "Arch Manning", Category: "passing", StatType: "C/ATT",
+			Stat: "18"},
+	})
+
+	assert.ErrorIs(t, err, ErrUnparseable)
+	assert.ErrorContains(t, err, "Arch Manning")
+}
+
+func TestPivotGameTeamStats_Fixture_ShouldSplitTypedAndExtras(t *testing.T) {
+	games := fixture.Load(t, "games_teams.json",
+		func() *cfbd.GameTeamStats { return &cfbd.GameTeamStats{} })
+	require.NotEmpty(t, games)
+
+	lines, err := PivotGameTeamStats(games)
+	require.NoError(t, err)
+
+	away := lines[games[0].GetId()]["Sam Houston"]
+	require.NotNil(t, away)
+	assert.Equal(t, "away", away.HomeAway)
+	require.NotNil(t, away.Passing)
+	assert.Equal(t, int32(13), away.Passing.Completions)
+	assert.Equal(t, int32(25), away.Passing.Attempts)
+	assert.Equal(t, int32(86), away.Passing.Yards)
+	assert.Equal(t, int32(1), away.Passing.Interceptions)
+	assert.Nil(t, away.Interceptions)
+	assert.Equal(t, int32(39), away.Defensive.Tackles)
+	assert.Equal(t, int32(2), away.Fumbles.Fumbles)
+	assert.Equal(t, int32(2), away.Extras["thirdDownEff"].Made)
+	assert.Contains(t, away.Extras, "possessionTime")
+
+	// Texas threw no interceptions and caught Sam Houston's one.
+	home := lines[games[0].GetId()]["Texas"]
+	require.NotNil(t, home)
+	require.NotNil(t, home.Passing)
+	assert.Equal(t, int32(0), home.Passing.Interceptions)
+	require.NotNil(t, home.Interceptions)
+	assert.Equal(t, int32(1), home.Interceptions.Interceptions)
+}
+
+func TestPivotTeamStats_Fixture_ShouldKeepOpponentStatsInExtras(t *testing.T) {
+	stats := fixture.Load(t, "stat_season.json",
+		func() *cfbd.TeamStat { return &cfbd.TeamStat{} })
+
+	lines, err := PivotTeamStats(stats)
+	require.NoError(t, err)
+
+	texas := lines["Texas"]
+	require.NotNil(t, texas)
+	assert.Equal(t, 16.0, texas.Extras["firstDowns"].Number)
+	require.NotNil(t, texas.Passing)
+	assert.Equal(t, int32(1), texas.Passing.Interceptions)
+	assert.Nil(t, texas.Interceptions)
+	for name := range texas.Extras {
+		_, typed := teamSetters[name]
+		assert.False(t, typed, name)
+	}
+}
@@ -0,0 +1,272 @@
+// Package stats parses the loosely typed stat values returned by the CFBD
+// API, such as "12-18" third down efficiency, "31:24" possession time or
+// "C/ATT" completions, into numbers, made/attempt pairs and durations.
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ErrUnparseable is returned when a stat value does not match the shape of
+// its kind.
+var ErrUnparseable = errors.New("unparseable stat value")
+
+// Kind is the shape of a stat value.
+type Kind int
+
+const (
+	// KindCount is a whole number such as yards or touchdowns.
+	KindCount Kind = iota
+	// KindDecimal is a rate such as yards per pass.
+	KindDecimal
+	// KindPercent is a percentage, reported either as 0-100 or 0-1.
+	KindPercent
+	// KindMadeAttempts is a pair such as "12-18" or "18/21".
+	KindMadeAttempts
+	// KindCountYards is a count and yardage pair such as "6-40" penalties.
+	KindCountYards
+	// KindDuration is a clock such as "31:24", or a number of seconds.
+	KindDuration
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindCount:
+		return "count"
+	case KindDecimal:
+		return "decimal"
+	case KindPercent:
+		return "percent"
+	case KindMadeAttempts:
+		return "made/attempts"
+	case KindCountYards:
+		return "count/yards"
+	case KindDuration:
+		return "duration"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Value is a parsed stat. Only the fields of its Kind are set.
+type Value struct {
+	Kind Kind
+	// Raw is the value as returned by the API.
+	Raw string
+	// Number holds KindCount, KindDecimal and KindPercent values as
+	// reported.
+	Number float64
+	// Made and Attempts hold KindMadeAttempts values.
+	Made     int32
+	Attempts int32
+	// Count and Yards hold KindCountYards values.
+	Count int32
+	Yards int32
+	// Duration holds KindDuration values.
+	Duration time.Duration
+}
+
+// Float returns the value as a single number: Number, the success rate of
+// made/attempt pairs, the yards of count/yards pairs, or seconds for
+// durations.
+func (v Value) Float() float64 {
+	switch v.Kind {
+	case KindMadeAttempts:
+		if v.Attempts == 0 {
+			return 0
+		}
+		return float64(v.Made) / float64(v.Attempts)
+	case KindCountYards:
+		return float64(v.Yards)
+	case KindDuration:
+		return v.Duration.Seconds()
+	}
+
+	return v.Number
+}
+
+// Fraction returns a KindPercent value as a fraction between 0 and 1. The
+// API reports some percentages as 0-100 and others as 0-1; values above 1
+// are taken to be on the 0-100 scale. Made/attempt pairs return their
+// success rate.
+func (v Value) Fraction() float64 {
+	if v.Kind == KindPercent && v.Number > 1 {
+		return v.Number / 100
+	}
+
+	return v.Float()
+}
+
+// ParseTeam parses raw as the team stat name, e.g. "thirdDownEff". Unknown
+// names are parsed as counts.
+func ParseTeam(name, raw string) (Value, error) {
+	k, _ := TeamKind(name)
+	v, err := Parse(k, raw)
+	if err != nil {
+		return v, fmt.Errorf("team stat %s; %w", name, err)
+	}
+
+	return v, nil
+}
+
+// ParsePlayer parses raw as the player stat type, e.g. "C/ATT". Unknown
+// types are parsed as counts.
+func ParsePlayer(statType, raw string) (Value, error) {
+	k, _ := PlayerKind(statType)
+	v, err := Parse(k, raw)
+	if err != nil {
+		return v, fmt.Errorf("player stat %s; %w", statType, err)
+	}
+
+	return v, nil
+}
+
+// Parse parses raw as a value of kind k.
+func Parse(k Kind, raw string) (Value, error) {
+	v := Value{Kind: k, Raw: raw}
+	s := strings.TrimSpace(raw)
+	var err error
+
+	switch k {
+	case KindCount, KindDecimal, KindPercent:
+		v.Number, err = strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		if err == nil && math.IsNaN(v.Number) {
+			err = strconv.ErrSyntax
+		}
+	case KindMadeAttempts:
+		v.Made, v.Attempts, err = parsePair(s)
+	case KindCountYards:
+		v.Count, v.Yards, err = parsePair(s)
+	case KindDuration:
+		v.Duration, err = parseClock(s)
+	default:
+		err = fmt.Errorf("unknown kind %d", int(k))
+	}
+	if err != nil {
+		return v, fmt.Errorf("%q as %s; %w", raw, k, ErrUnparseable)
+	}
+
+	return v, nil
+}
+
+// parsePair parses "12-18" or "18/21". The second number may be negative,
+// as in "3--5" for negative penalty yardage.
+func parsePair(s string) (int32, int32, error) {
+	sep := strings.IndexAny(s, "-/")
+	if sep < 1 {
+		return 0, 0, strconv.ErrSyntax
+	}
+
+	first, err := strconv.ParseInt(s[:sep], 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	second, err := strconv.ParseInt(s[sep+1:], 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int32(first), int32(second), nil
+}
+
+// parseClock parses "mm:ss" or a plain number of seconds.
+func parseClock(s string) (time.Duration, error) {
+	minutes, seconds, found := strings.Cut(s, ":")
+	if !found {
+		secs, err := strconv.ParseFloat(s, 64)
+		if err != nil || secs < 0 {
+			return 0, strconv.ErrSyntax
+		}
+		return time.Duration(secs * float64(time.Second)), nil
+	}
+
+	m, err := strconv.Atoi(minutes)
+	if err != nil || m < 0 {
+		return 0, strconv.ErrSyntax
+	}
+	sec, err := strconv.Atoi(seconds)
+	if err != nil || sec < 0 || sec > 59 {
+		return 0, strconv.ErrSyntax
+	}
+
+	return time.Duration(m)*time.Minute + time.Duration(sec)*time.Second, nil
+}
+
+// GameTeamStat parses a box score stat returned by GetGameTeams.
+func GameTeamStat(s *cfbd.GameTeamStatsTeamStat) (Value, error) {
+	return ParseTeam(s.GetCategory(), s.GetStat())
+}
+
+// GameTeamStats parses every box score stat of a team, keyed by category.
+func GameTeamStats(team *cfbd.GameTeamStatsTeam) (map[string]Value, error) {
+	out := make(map[string]Value, len(team.GetStats()))
+	for _, s := range team.GetStats() {
+		v, err := GameTeamStat(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s; %w", team.GetTeam(), err)
+		}
+		out[s.GetCategory()] = v
+	}
+
+	return out, nil
+}
+
+// TeamStat parses a season stat returned by GetTeamSeasonStats, whose value
+// is a JSON number or string.
+func TeamStat(s *cfbd.TeamStat) (Value, error) {
+	raw, err := valueString(s.GetStatValue())
+	if err != nil {
+		return Value{}, fmt.Errorf("team stat %s; %w", s.GetStatName(), err)
+	}
+
+	return ParseTeam(s.GetStatName(), raw)
+}
+
+// TeamStats parses season stats into a map of team to stat name to value.
+func TeamStats(stats []*cfbd.TeamStat) (map[string]map[string]Value, error) {
+	out := make(map[string]map[string]Value)
+	for _, s := range stats {
+		v, err := TeamStat(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s; %w", s.GetTeam(), err)
+		}
+		if out[s.GetTeam()] == nil {
+			out[s.GetTeam()] = make(map[string]Value)
+		}
+		out[s.GetTeam()][s.GetStatName()] = v
+	}
+
+	return out, nil
+}
+
+// PlayerStat parses a season stat returned by GetPlayerSeasonStats.
+func PlayerStat(s *cfbd.PlayerStat) (Value, error) {
+	return ParsePlayer(s.GetStatType(), s.GetStat())
+}
+
+// GamePlayerStat parses one player's stat of the given type from
+// GetGamePlayers.
+func GamePlayerStat(
+	statType *cfbd.GamePlayerStatTypes, player *cfbd.GamePlayerStatPlayer,
+) (Value, error) {
+	return ParsePlayer(statType.GetName(), player.GetStat())
+}
+
+func valueString(v *structpb.Value) (string, error) {
+	switch kind := v.GetKind().(type) {
+	case *structpb.Value_NumberValue:
+		return strconv.FormatFloat(kind.NumberValue, 'f', -1, 64), nil
+	case *structpb.Value_StringValue:
+		return kind.StringValue, nil
+	}
+
+	return "", fmt.Errorf("%v; %w", v.AsInterface(), ErrUnparseable)
+}
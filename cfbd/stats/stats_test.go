@@ -0,0 +1,173 @@
+package stats
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestTeamKind_StatCategoriesFixture_ShouldAllBeKnown(t *testing.T) {
+	var categories []string
+	require.NoError(t, json.Unmarshal(fixture.Read(t, "stat_categories.json"),
+		&categories))
+
+	for _, name := range categories {
+		_, ok := TeamKind(name)
+		assert.True(t, ok, name)
+	}
+	assert.Subset(t, TeamCategories(), categories)
+}
+
+func TestTeamKind_OpponentVariant_ShouldMatchBaseStat(t *testing.T) {
+	k, ok := TeamKind("possessionTimeOpponent")
+
+	assert.True(t, ok)
+	assert.Equal(t, KindDuration, k)
+}
+
+func TestGameTeamStats_Fixture_ShouldParseEveryCategory(t *testing.T) {
+	games := fixture.Load(t, "games_teams.json",
+		func() *cfbd.GameTeamStats { return &cfbd.GameTeamStats{} })
+	require.NotEmpty(t, games)
+
+	values, err := GameTeamStats(games[0].GetTeams()[0])
+	require.NoError(t, err)
+
+	third := values["thirdDownEff"]
+	assert.Equal(t, KindMadeAttempts, third.Kind)
+	assert.Equal(t, int32(2), third.Made)
+	assert.Equal(t, int32(14), third.Attempts)
+	assert.InDelta(t, 2.0/14, third.Float(), 1e-9)
+
+	assert.Equal(t, 26*time.Minute+9*time.Second,
+		values["possessionTime"].Duration)
+	assert.Equal(t, int32(6), values["totalPenaltiesYards"].Count)
+	assert.Equal(t, int32(40), values["totalPenaltiesYards"].Yards)
+	assert.Equal(t, 3.4, values["yardsPerPass"].Number)
+	assert.Equal(t, 113.0, values["totalYards"].Float())
+}
+
+func TestTeamStats_Fixture_ShouldParseNumericValues(t *testing.T) {
+	stats := fixture.Load(t, "stat_season.json",
+		func() *cfbd.TeamStat { return &cfbd.TeamStat{} })
+
+	values, err := TeamStats(stats)
+	require.NoError(t, err)
+
+	texas := values["Texas"]
+	require.NotNil(t, texas)
+	assert.Equal(t, KindCount, texas["firstDowns"].Kind)
+	assert.Equal(t, 16.0, texas["firstDowns"].Number)
+	assert.Equal(t, KindDuration, texas["possessionTime"].Kind)
+	assert.Positive(t, texas["possessionTime"].Duration)
+}
+
+func TestTeamStat_StringValue_ShouldParseClock(t *testing.T) {
+	v, err := TeamStat(&cfbd.TeamStat{
+		StatName:  "possessionTime",
+		StatValue: structpb.NewStringValue("31:24"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 31*time.Minute+24*time.Second, v.Duration)
+}
+
+func TestTeamStat_NullValue_ShouldError(t *testing.T) {
+	_, err := TeamStat(&cfbd.TeamStat{
+		StatName:  "firstDowns",
+		StatValue: structpb.NewNullValue(),
+	})
+
+	assert.ErrorIs(t, err, ErrUnparseable)
+}
+
+func TestPlayerStat_Fixture_ShouldParseFractionPercent(t *testing.T) {
+	stats := fixture.Load(t, "stat_player_season.json",
+		func() *cfbd.PlayerStat { return &cfbd.PlayerStat{} })
+
+	for _, s := range stats {
+		v, err := PlayerStat(s)
+		require.NoError(t, err, s.GetStatType())
+		if s.GetStatType() == "PCT" {
+			assert.Equal(t, KindPercent, v.Kind)
+			assert.InDelta(t, 0.567, v.Fraction(), 1e-9)
+		}
+	}
+}
+
+func TestGamePlayerStat_Fixture_ShouldParseEveryType(t *testing.T) {
+	games := fixture.Load(t, "games_players.json",
+		func() *cfbd.GamePlayerStats { return &cfbd.GamePlayerStats{} })
+	require.NotEmpty(t, games)
+
+	var completions *Value
+	for _, team := range games[0].GetTeams() {
+		for _, category := range team.GetCategories() {
+			for _, statType := range category.GetTypes() {
+				for _, player := range statType.GetAthletes() {
+					v, err := GamePlayerStat(statType, player)
+					require.NoError(t, err, statType.GetName())
+					if completions == nil && statType.GetName() == "C/ATT" {
+						completions = &v
+					}
+				}
+			}
+		}
+	}
+
+	require.NotNil(t, completions)
+	assert.Equal(t, KindMadeAttempts, completions.Kind)
+	assert.Positive(t, completions.Attempts)
+}
+
+func TestParse_Shapes_ShouldParse(t *testing.T) {
+	for _, tc := range []struct {
+		kind Kind
+		raw  string
+		want Value
+	}{
+		{KindCount, "-5", Value{Number: -5}},
+		{KindPercent, "100.0", Value{Number: 100}},
+		{KindPercent, "45%", Value{Number: 45}},
+		{KindMadeAttempts, "18/21", Value{Made: 18, Attempts: 21}},
+		{KindCountYards, "3--5", Value{Count: 3, Yards: -5}},
+		{KindDuration, "1800", Value{Duration: 30 * time.Minute}},
+		{KindDuration, "0:07", Value{Duration: 7 * time.Second}},
+	} {
+		got, err := Parse(tc.kind, tc.raw)
+		require.NoError(t, err, tc.raw)
+		tc.want.Kind, tc.want.Raw = tc.kind, tc.raw
+		assert.Equal(t, tc.want, got, tc.raw)
+	}
+}
+
+func TestParse_Unparseable_ShouldNameStatAndValue(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		raw  string
+	}{
+		{"thirdDownEff", "12"},
+		{"possessionTime", "31:75"},
+		{"firstDowns", "--"},
+		{"yardsPerPass", "NaN"},
+	} {
+		_, err := ParseTeam(tc.name, tc.raw)
+		require.ErrorIs(t, err, ErrUnparseable, tc.raw)
+		assert.ErrorContains(t, err, tc.name)
+		assert.ErrorContains(t, err, tc.raw)
+	}
+}
+
+func TestValue_Fraction_ShouldNormalizePercentScales(t *testing.T) {
+	assert.InDelta(t, 0.8,
+		Value{Kind: KindPercent, Number: 80}.Fraction(), 1e-9)
+	assert.InDelta(t, 0.8,
+		Value{Kind: KindPercent, Number: 0.8}.Fraction(), 1e-9)
+	assert.Zero(t, Value{Kind: KindMadeAttempts}.Fraction())
+}
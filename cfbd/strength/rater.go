@@ -0,0 +1,143 @@
+package strength
+
+import (
+	"math"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/elo"
+	"github.com/clintrovert/cfbd-go/cfbd/srs"
+)
+
+// DefaultSigma is the standard deviation, in points, of a game's margin
+// around the difference in point ratings.
+const DefaultSigma = 16.0
+
+// Rater rates teams and turns a difference in ratings into a win
+// probability, so that ratings on different scales can be compared.
+type Rater interface {
+	// Rating returns the rating of team, or false when it is not rated.
+	Rating(team string) (float64, bool)
+	// WinProbability returns the probability that a team rated diff above
+	// its opponent wins.
+	WinProbability(diff float64) float64
+}
+
+// Points rates teams on a point scale, such as SP+, SRS or FPI, with game
+// margins normally distributed around the rating difference.
+type Points struct {
+	ratings map[string]float64
+	sigma   float64
+}
+
+var _ Rater = (*Points)(nil)
+
+// NewPoints returns a point rater with margins spread by sigma points, or
+// by DefaultSigma when sigma is not positive.
+func NewPoints(ratings map[string]float64, sigma float64) *Points {
+	if sigma <= 0 {
+		sigma = DefaultSigma
+	}
+
+	return &Points{ratings: ratings, sigma: sigma}
+}
+
+// Rating returns the rating of team.
+func (p *Points) Rating(team string) (float64, bool) {
+	r, ok := p.ratings[team]
+	return r, ok
+}
+
+// WinProbability returns the chance a normally distributed margin with
+// mean diff is positive.
+func (p *Points) WinProbability(diff float64) float64 {
+	return 0.5 * math.Erfc(-diff/(p.sigma*math.Sqrt2))
+}
+
+// Elo rates teams on the Elo scale.
+type Elo struct {
+	ratings map[string]float64
+}
+
+var _ Rater = (*Elo)(nil)
+
+// NewElo returns an Elo rater.
+func NewElo(ratings map[string]float64) *Elo {
+	return &Elo{ratings: ratings}
+}
+
+// Rating returns the rating of team.
+func (e *Elo) Rating(team string) (float64, bool) {
+	r, ok := e.ratings[team]
+	return r, ok
+}
+
+// WinProbability returns the Elo expected score for diff.
+func (e *Elo) WinProbability(diff float64) float64 {
+	return elo.Expected(diff)
+}
+
+// FromSP rates teams by TeamSP.rating.
+func FromSP(ratings []*cfbd.TeamSP) *Points {
+	m := make(map[string]float64, len(ratings))
+	for _, r := range ratings {
+		if r.Rating != nil {
+			m[r.GetTeam()] = r.GetRating()
+		}
+	}
+
+	return NewPoints(m, DefaultSigma)
+}
+
+// FromSRS rates teams by TeamSRS.rating.
+func FromSRS(ratings []*cfbd.TeamSRS) *Points {
+	m := make(map[string]float64, len(ratings))
+	for _, r := range ratings {
+		m[r.GetTeam()] = r.GetRating()
+	}
+
+	return NewPoints(m, DefaultSigma)
+}
+
+// FromFPI rates teams by TeamFPI.fpi.
+func FromFPI(ratings []*cfbd.TeamFPI) *Points {
+	m := make(map[string]float64, len(ratings))
+	for _, r := range ratings {
+		if r.Fpi != nil {
+			m[r.GetTeam()] = r.GetFpi()
+		}
+	}
+
+	return NewPoints(m, DefaultSigma)
+}
+
+// FromElo rates teams by TeamElo.elo.
+func FromElo(ratings []*cfbd.TeamElo) *Elo {
+	m := make(map[string]float64, len(ratings))
+	for _, r := range ratings {
+		if r.Elo != nil {
+			m[r.GetTeam()] = float64(r.GetElo())
+		}
+	}
+
+	return NewElo(m)
+}
+
+// FromSolvedSRS rates teams by ratings solved with the srs package.
+func FromSolvedSRS(res srs.Result) *Points {
+	m := make(map[string]float64, len(res.Ratings))
+	for _, r := range res.Ratings {
+		m[r.Team] = r.Rating
+	}
+
+	return NewPoints(m, DefaultSigma)
+}
+
+// FromEngine rates teams by the current ratings of an elo.Engine.
+func FromEngine(e *elo.Engine) *Elo {
+	m := make(map[string]float64)
+	for _, r := range e.Ratings() {
+		m[r.GetTeam()] = e.Rating(r.GetTeam())
+	}
+
+	return NewElo(m)
+}
@@ -0,0 +1,63 @@
+package strength
+
+import (
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/elo"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestFrom_Fixtures_ShouldRateTexas(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rater Rater
+		want  float64
+	}{
+		"sp": {FromSP(fixture.Load(t, "ratings_sp.json",
+			func() *cfbd.TeamSP { return &cfbd.TeamSP{} })), 14.7},
+		"srs": {FromSRS(fixture.Load(t, "ratings_srs.json",
+			func() *cfbd.TeamSRS { return &cfbd.TeamSRS{} })), 12},
+		"fpi": {FromFPI(fixture.Load(t, "ratings_fpi.json",
+			func() *cfbd.TeamFPI { return &cfbd.TeamFPI{} })), 18.267},
+		"elo": {FromElo(fixture.Load(t, "ratings_elo.json",
+			func() *cfbd.TeamElo { return &cfbd.TeamElo{} })), 1925},
+	} {
+		r, ok := tc.rater.Rating("Texas")
+		require.True(t, ok, name)
+		assert.InDelta(t, tc.want, r, 1e-9, name)
+		_, ok = tc.rater.Rating("Georgia Tech")
+		assert.False(t, ok, name)
+	}
+}
+
+func TestWinProbability_ShouldFollowScale(t *testing.T) {
+	points := NewPoints(nil, DefaultSigma)
+	assert.InDelta(t, 0.5, points.WinProbability(0), 1e-9)
+	assert.InDelta(t, 0.8413, points.WinProbability(DefaultSigma), 1e-4)
+	assert.InDelta(t, 1, points.WinProbability(7)+points.WinProbability(-7),
+		1e-9)
+
+	assert.InDelta(t, elo.Expected(100), NewElo(nil).WinProbability(100),
+		1e-9)
+}
+
+func TestNewPoints_NonPositiveSigma_ShouldUseDefault(t *testing.T) {
+	want := NewPoints(nil, DefaultSigma).WinProbability(7)
+
+	assert.InDelta(t, want, NewPoints(nil, 0).WinProbability(7), 1e-12)
+	assert.InDelta(t, want, NewPoints(nil, -3).WinProbability(7), 1e-12)
+}
+
+func TestFromEngine_ShouldUseCurrentRatings(t *testing.T) {
+	e := elo.New(elo.WithRatings([]*cfbd.TeamElo{
+		{Year: 2025, Team: "Texas", Elo: proto.Int32(1925)},
+	}))
+
+	r, ok := FromEngine(e).Rating("Texas")
+
+	require.True(t, ok)
+	assert.InDelta(t, 1925, r, 1e-9)
+}
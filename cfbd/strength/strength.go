@@ -0,0 +1,283 @@
+// Package strength measures schedules: past and remaining strength of
+// schedule from any rating source, and strength of record, the chance a
+// benchmark top-25 team would do as well against the same schedule.
+package strength
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// benchmarkTop is how many of the best rated teams make up the default
+// benchmark.
+const benchmarkTop = 25
+
+// Option configures a Calculator.
+type Option func(*Calculator)
+
+// WithHomeField sets the home field advantage, in the rater's units, used
+// for strength of record. The default is 0.
+func WithHomeField(advantage float64) Option {
+	return func(c *Calculator) {
+		c.homeField = advantage
+	}
+}
+
+// WithBenchmark sets the rating of the benchmark team for strength of
+// record. The default is the average rating of the 25 best rated teams in
+// the games.
+func WithBenchmark(rating float64) Option {
+	return func(c *Calculator) {
+		c.benchmark = &rating
+	}
+}
+
+// WithUnratedRating sets the rating assumed for opponents the rater does
+// not know, such as FCS teams. The default is the lowest rating of any
+// team in the games.
+func WithUnratedRating(rating float64) Option {
+	return func(c *Calculator) {
+		c.unrated = &rating
+	}
+}
+
+// Schedule is one team's schedule strength and record strength.
+type Schedule struct {
+	Team string
+	// Rated is false when the rater does not know the team itself.
+	Rated  bool
+	Wins   int
+	Losses int
+	Ties   int
+	// Played and Remaining count completed and upcoming games.
+	Played    int
+	Remaining int
+	// SOS and RemainingSOS are the average opponent rating of completed
+	// and upcoming games. They are zero without such games.
+	SOS          float64
+	RemainingSOS float64
+	// SOR is the probability that the benchmark team wins at least as many
+	// of the completed games. Lower is a stronger record.
+	SOR float64
+	// RecordProbability is the probability that the benchmark team wins
+	// exactly as many of the completed games.
+	RecordProbability float64
+	// SOSRank, RemainingSOSRank and SORRank are 1-based, hardest schedule
+	// and strongest record first, among teams with games of that kind.
+	SOSRank          int
+	RemainingSOSRank int
+	SORRank          int
+}
+
+// Calculator measures schedules against one rater.
+type Calculator struct {
+	rater     Rater
+	homeField float64
+	benchmark *float64
+	unrated   *float64
+}
+
+// New returns a calculator rating opponents with rater.
+func New(rater Rater, opts ...Option) *Calculator {
+	c := &Calculator{rater: rater}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// matchup is one game from a team's point of view.
+type matchup struct {
+	opponent string
+	// site is +1 at home, -1 away and 0 at a neutral site.
+	site      float64
+	completed bool
+	margin    int32
+}
+
+// Schedules measures every team in games. Completed games count toward
+// the record, SOS and SOR; games not completed count toward remaining
+// SOS. Teams are returned in name order.
+func (c *Calculator) Schedules(games []*cfbd.Game) []Schedule {
+	matchups := collect(games)
+	benchmark, unrated := c.defaults(matchups)
+
+	out := make([]Schedule, 0, len(matchups))
+	for team, ms := range matchups {
+		out = append(out, c.schedule(team, ms, benchmark, unrated))
+	}
+
+	slices.SortFunc(out, func(a, b Schedule) int {
+		return cmp.Compare(a.Team, b.Team)
+	})
+	rankBy(out, func(s *Schedule) (float64, bool) {
+		return -s.SOS, s.Played > 0
+	}, func(s *Schedule, rank int) { s.SOSRank = rank })
+	rankBy(out, func(s *Schedule) (float64, bool) {
+		return -s.RemainingSOS, s.Remaining > 0
+	}, func(s *Schedule, rank int) { s.RemainingSOSRank = rank })
+	rankBy(out, func(s *Schedule) (float64, bool) {
+		return s.SOR, s.Played > 0
+	}, func(s *Schedule, rank int) { s.SORRank = rank })
+
+	return out
+}
+
+func (c *Calculator) schedule(
+	team string, ms []matchup, benchmark, unrated float64,
+) Schedule {
+	_, rated := c.rater.Rating(team)
+	s := Schedule{Team: team, Rated: rated}
+	var past, future, odds []float64
+	for _, m := range ms {
+		r, ok := c.rater.Rating(m.opponent)
+		if !ok {
+			r = unrated
+		}
+		if !m.completed {
+			future = append(future, r)
+			continue
+		}
+		past = append(past, r)
+		odds = append(odds,
+			c.rater.WinProbability(benchmark-r+m.site*c.homeField))
+		s.record(m.margin)
+	}
+
+	s.Played, s.Remaining = len(past), len(future)
+	s.SOS, s.RemainingSOS = mean(past), mean(future)
+	dist := wins(odds)
+	s.RecordProbability = dist[s.Wins]
+	for _, p := range dist[s.Wins:] {
+		s.SOR += p
+	}
+
+	return s
+}
+
+func (s *Schedule) record(margin int32) {
+	switch {
+	case margin > 0:
+		s.Wins++
+	case margin < 0:
+		s.Losses++
+	default:
+		s.Ties++
+	}
+}
+
+func collect(games []*cfbd.Game) map[string][]matchup {
+	out := make(map[string][]matchup)
+	for _, g := range games {
+		completed := g.GetCompleted() && g.HomePoints != nil &&
+			g.AwayPoints != nil
+		margin := g.GetHomePoints() - g.GetAwayPoints()
+		site := 1.0
+		if g.GetNeutralSite() {
+			site = 0
+		}
+		out[g.GetHomeTeam()] = append(out[g.GetHomeTeam()], matchup{
+			opponent: g.GetAwayTeam(), site: site,
+			completed: completed, margin: margin,
+		})
+		out[g.GetAwayTeam()] = append(out[g.GetAwayTeam()], matchup{
+			opponent: g.GetHomeTeam(), site: -site,
+			completed: completed, margin: -margin,
+		})
+	}
+
+	return out
+}
+
+// defaults returns the benchmark and unrated ratings, derived from the
+// rated teams in the games unless they were set.
+func (c *Calculator) defaults(
+	matchups map[string][]matchup,
+) (float64, float64) {
+	var ratings []float64
+	for team := range matchups {
+		if r, ok := c.rater.Rating(team); ok {
+			ratings = append(ratings, r)
+		}
+	}
+	slices.SortFunc(ratings, func(a, b float64) int {
+		return cmp.Compare(b, a)
+	})
+
+	var benchmark, unrated float64
+	if len(ratings) > 0 {
+		benchmark = mean(ratings[:min(benchmarkTop, len(ratings))])
+		unrated = ratings[len(ratings)-1]
+	}
+	if c.benchmark != nil {
+		benchmark = *c.benchmark
+	}
+	if c.unrated != nil {
+		unrated = *c.unrated
+	}
+
+	return benchmark, unrated
+}
+
+// wins returns the distribution of the number of wins in games won with
+// the given probabilities.
+func wins(odds []float64) []float64 {
+	dist := make([]float64, len(odds)+1)
+	dist[0] = 1
+	for i, p := range odds {
+		for k := i + 1; k > 0; k-- {
+			dist[k] = dist[k]*(1-p) + dist[k-1]*p
+		}
+		dist[0] *= 1 - p
+	}
+
+	return dist
+}
+
+func mean(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+
+	var sum float64
+	for _, x := range xs {
+		sum += x
+	}
+
+	return sum / float64(len(xs))
+}
+
+// rankBy ranks the schedules for which key reports true by ascending key;
+// ties share a rank.
+func rankBy(
+	schedules []Schedule,
+	key func(*Schedule) (float64, bool),
+	set func(*Schedule, int),
+) {
+	var ranked []*Schedule
+	for i := range schedules {
+		if _, ok := key(&schedules[i]); ok {
+			ranked = append(ranked, &schedules[i])
+		}
+	}
+	slices.SortStableFunc(ranked, func(a, b *Schedule) int {
+		ka, _ := key(a)
+		kb, _ := key(b)
+		return cmp.Compare(ka, kb)
+	})
+
+	prev := math.NaN()
+	rank := 0
+	for i, s := range ranked {
+		k, _ := key(s)
+		if k != prev {
+			rank = i + 1
+			prev = k
+		}
+		set(s, rank)
+	}
+}
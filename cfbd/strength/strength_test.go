@@ -0,0 +1,85 @@
+package strength
+
+import (
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func final(home, away string, homePts, awayPts int32) *cfbd.Game {
+	return &cfbd.Game{
+		Completed:  true,
+		HomeTeam:   home,
+		HomePoints: &homePts,
+		AwayTeam:   away,
+		AwayPoints: &awayPts,
+	}
+}
+
+func fixtureSchedules(t *testing.T, opts ...Option) map[string]Schedule {
+	rater := NewPoints(map[string]float64{
+		"A": 20, "B": 10, "C": 0, "D": -10,
+	}, DefaultSigma)
+	games := []*cfbd.Game{
+		final("A", "B", 31, 24),
+		final("C", "A", 10, 17),
+		final("B", "Montana", 42, 3),
+		{HomeTeam: "A", AwayTeam: "D"},
+	}
+
+	out := make(map[string]Schedule)
+	for _, s := range New(rater, opts...).Schedules(games) {
+		out[s.Team] = s
+	}
+	require.Len(t, out, 5)
+
+	return out
+}
+
+func TestSchedules_ShouldAverageOpponentRatings(t *testing.T) {
+	s := fixtureSchedules(t, WithUnratedRating(-20))
+
+	a := s["A"]
+	assert.Equal(t, 2, a.Wins)
+	assert.Equal(t, 2, a.Played)
+	assert.Equal(t, 1, a.Remaining)
+	assert.InDelta(t, 5, a.SOS, 1e-9)
+	assert.InDelta(t, -10, a.RemainingSOS, 1e-9)
+	assert.InDelta(t, 0, s["B"].SOS, 1e-9)
+	assert.False(t, s["Montana"].Rated)
+	assert.Equal(t, 1, s["C"].SOSRank)
+	assert.Equal(t, 1, s["D"].RemainingSOSRank)
+	assert.Zero(t, s["B"].RemainingSOSRank)
+}
+
+func TestSchedules_ShouldComputeStrengthOfRecord(t *testing.T) {
+	s := fixtureSchedules(t)
+
+	// The default benchmark is the average of the rated teams, 5.
+	rater := NewPoints(nil, DefaultSigma)
+	pB, pC := rater.WinProbability(5-10), rater.WinProbability(5-0)
+	a := s["A"]
+	assert.InDelta(t, pB*pC, a.SOR, 1e-9)
+	assert.InDelta(t, pB*pC, a.RecordProbability, 1e-9)
+	assert.Equal(t, 1, a.SORRank)
+
+	// B lost to A and beat Montana, rated as the lowest team, -10.
+	pA, pM := rater.WinProbability(5-20), rater.WinProbability(5+10)
+	b := s["B"]
+	assert.InDelta(t, pA*(1-pM)+(1-pA)*pM, b.RecordProbability, 1e-9)
+	assert.InDelta(t, 1-(1-pA)*(1-pM), b.SOR, 1e-9)
+}
+
+func TestSchedules_HomeFieldAndBenchmark_ShouldShiftOdds(t *testing.T) {
+	s := fixtureSchedules(t, WithBenchmark(15), WithHomeField(3))
+
+	rater := NewPoints(nil, DefaultSigma)
+	want := rater.WinProbability(15-10+3) * rater.WinProbability(15-0-3)
+	assert.InDelta(t, want, s["A"].SOR, 1e-9)
+}
+
+func TestSchedules_NoGames_ShouldBeEmpty(t *testing.T) {
+	assert.Empty(t, New(NewElo(nil)).Schedules(nil))
+}
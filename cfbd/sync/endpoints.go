@@ -0,0 +1,199 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"google.golang.org/protobuf/proto"
+)
+
+// Endpoint names a synced CFBD resource.
+type Endpoint string
+
+const (
+	// EndpointGames holds *cfbd.Game records keyed by game ID.
+	EndpointGames Endpoint = "games"
+	// EndpointDrives holds *cfbd.Drive records keyed by drive ID.
+	EndpointDrives Endpoint = "drives"
+	// EndpointPlays holds *cfbd.Play records keyed by play ID.
+	EndpointPlays Endpoint = "plays"
+	// EndpointTeamStats holds *cfbd.GameTeamStats records keyed by game ID.
+	EndpointTeamStats Endpoint = "games/teams"
+	// EndpointPlayerStats holds *cfbd.GamePlayerStats records keyed by game
+	// ID.
+	EndpointPlayerStats Endpoint = "games/players"
+	// EndpointLines holds *cfbd.BettingGame records keyed by game ID.
+	EndpointLines Endpoint = "lines"
+	// EndpointRankings holds *cfbd.PollWeek records keyed by season type and
+	// week.
+	EndpointRankings Endpoint = "rankings"
+	// EndpointSPRatings holds season *cfbd.TeamSP records keyed by team.
+	EndpointSPRatings Endpoint = "ratings/sp"
+	// EndpointSRSRatings holds season *cfbd.TeamSRS records keyed by team.
+	EndpointSRSRatings Endpoint = "ratings/srs"
+	// EndpointEloRatings holds season *cfbd.TeamElo records keyed by team.
+	EndpointEloRatings Endpoint = "ratings/elo"
+)
+
+// Source is the subset of *cfbd.Client used to sync a season.
+type Source interface {
+	GetCalendar(
+		ctx context.Context, request cfbd.GetCalendarRequest,
+	) ([]*cfbd.CalendarWeek, error)
+	GetGames(
+		ctx context.Context, request cfbd.GetGamesRequest,
+	) ([]*cfbd.Game, error)
+	GetDrives(
+		ctx context.Context, request cfbd.GetDrivesRequest,
+	) ([]*cfbd.Drive, error)
+	GetPlays(
+		ctx context.Context, request cfbd.GetPlaysRequest,
+	) ([]*cfbd.Play, error)
+	GetGameTeams(
+		ctx context.Context, request cfbd.GetGameTeamsRequest,
+	) ([]*cfbd.GameTeamStats, error)
+	GetGamePlayers(
+		ctx context.Context, request cfbd.GetGamePlayersRequest,
+	) ([]*cfbd.GamePlayerStats, error)
+	GetBettingLines(
+		ctx context.Context, request cfbd.GetBettingLinesRequest,
+	) ([]*cfbd.BettingGame, error)
+	GetRankings(
+		ctx context.Context, request cfbd.GetRankingsRequest,
+	) ([]*cfbd.PollWeek, error)
+	GetTeamSPPlusRatings(
+		ctx context.Context, request cfbd.GetSPPlusRatingsRequest,
+	) ([]*cfbd.TeamSP, error)
+	GetSRSRatings(
+		ctx context.Context, request cfbd.GetSRSRatingsRequest,
+	) ([]*cfbd.TeamSRS, error)
+	GetEloRatings(
+		ctx context.Context, request cfbd.GetEloRatingsRequest,
+	) ([]*cfbd.TeamElo, error)
+}
+
+var _ Source = (*cfbd.Client)(nil)
+
+type record struct {
+	key string
+	msg proto.Message
+}
+
+func records[T proto.Message](msgs []T, key func(T) string) []record {
+	out := make([]record, 0, len(msgs))
+	for _, m := range msgs {
+		out = append(out, record{key: key(m), msg: m})
+	}
+
+	return out
+}
+
+// fetcher retrieves every record of one unit.
+type fetcher func(ctx context.Context, src Source, u unit) ([]record, error)
+
+// weekly lists the endpoints fetched one week at a time, in sync order.
+// Games are fetched for the whole season up front and are not listed.
+var weekly = []struct {
+	endpoint Endpoint
+	fetch    fetcher
+}{
+	{EndpointDrives, fetchDrives},
+	{EndpointPlays, fetchPlays},
+	{EndpointTeamStats, fetchTeamStats},
+	{EndpointPlayerStats, fetchPlayerStats},
+	{EndpointLines, fetchLines},
+	{EndpointRankings, fetchRankings},
+}
+
+// seasonal lists the endpoints fetched once for the whole season.
+var seasonal = []struct {
+	endpoint Endpoint
+	fetch    fetcher
+}{
+	{EndpointSPRatings, fetchSPRatings},
+	{EndpointSRSRatings, fetchSRSRatings},
+	{EndpointEloRatings, fetchEloRatings},
+}
+
+func gameKey(id int32) string {
+	return strconv.FormatInt(int64(id), 10)
+}
+
+func fetchDrives(ctx context.Context, src Source, u unit) ([]record, error) {
+	drives, err := src.GetDrives(ctx, cfbd.GetDrivesRequest{
+		Year: u.season, SeasonType: u.seasonType, Week: u.week,
+	})
+	return records(drives, (*cfbd.Drive).GetId), err
+}
+
+func fetchPlays(ctx context.Context, src Source, u unit) ([]record, error) {
+	plays, err := src.GetPlays(ctx, cfbd.GetPlaysRequest{
+		Year: u.season, SeasonType: u.seasonType, Week: u.week,
+	})
+	return records(plays, (*cfbd.Play).GetId), err
+}
+
+func fetchTeamStats(ctx context.Context, src Source, u unit) ([]record, error) {
+	stats, err := src.GetGameTeams(ctx, cfbd.GetGameTeamsRequest{
+		Year: u.season, SeasonType: u.seasonType, Week: u.week,
+	})
+	return records(stats, func(s *cfbd.GameTeamStats) string {
+		return gameKey(s.GetId())
+	}), err
+}
+
+func fetchPlayerStats(
+	ctx context.Context, src Source, u unit,
+) ([]record, error) {
+	stats, err := src.GetGamePlayers(ctx, cfbd.GetGamePlayersRequest{
+		Year: u.season, SeasonType: u.seasonType, Week: u.week,
+	})
+	return records(stats, func(s *cfbd.GamePlayerStats) string {
+		return gameKey(s.GetId())
+	}), err
+}
+
+func fetchLines(ctx context.Context, src Source, u unit) ([]record, error) {
+	lines, err := src.GetBettingLines(ctx, cfbd.GetBettingLinesRequest{
+		Year: u.season, SeasonType: u.seasonType, Week: u.week,
+	})
+	return records(lines, func(g *cfbd.BettingGame) string {
+		return gameKey(g.GetId())
+	}), err
+}
+
+func fetchRankings(ctx context.Context, src Source, u unit) ([]record, error) {
+	polls, err := src.GetRankings(ctx, cfbd.GetRankingsRequest{
+		Year: u.season, SeasonType: u.seasonType, Week: float64(u.week),
+	})
+	return records(polls, func(p *cfbd.PollWeek) string {
+		return fmt.Sprintf("%s/%d", p.GetSeasonType(), p.GetWeek())
+	}), err
+}
+
+func fetchSPRatings(ctx context.Context, src Source, u unit) ([]record, error) {
+	ratings, err := src.GetTeamSPPlusRatings(ctx, cfbd.GetSPPlusRatingsRequest{
+		Year: u.season,
+	})
+	return records(ratings, (*cfbd.TeamSP).GetTeam), err
+}
+
+func fetchSRSRatings(
+	ctx context.Context, src Source, u unit,
+) ([]record, error) {
+	ratings, err := src.GetSRSRatings(ctx, cfbd.GetSRSRatingsRequest{
+		Year: u.season,
+	})
+	return records(ratings, (*cfbd.TeamSRS).GetTeam), err
+}
+
+func fetchEloRatings(
+	ctx context.Context, src Source, u unit,
+) ([]record, error) {
+	ratings, err := src.GetEloRatings(ctx, cfbd.GetEloRatingsRequest{
+		Year: u.season,
+	})
+	return records(ratings, (*cfbd.TeamElo).GetTeam), err
+}
@@ -0,0 +1,318 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	// Registers the pure-Go "sqlite" database/sql driver.
+	_ "modernc.org/sqlite"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS records (
+	endpoint    TEXT    NOT NULL,
+	season      INTEGER NOT NULL,
+	season_type TEXT    NOT NULL,
+	week        INTEGER NOT NULL,
+	key         TEXT    NOT NULL,
+	digest      BLOB    NOT NULL,
+	data        BLOB    NOT NULL,
+	updated_at  TEXT    NOT NULL,
+	PRIMARY KEY (endpoint, season, season_type, week, key)
+);
+CREATE TABLE IF NOT EXISTS watermarks (
+	endpoint    TEXT    NOT NULL,
+	season      INTEGER NOT NULL,
+	season_type TEXT    NOT NULL,
+	week        INTEGER NOT NULL,
+	state       TEXT    NOT NULL,
+	synced_at   TEXT    NOT NULL,
+	PRIMARY KEY (endpoint, season, season_type, week)
+);
+CREATE TABLE IF NOT EXISTS runs (
+	id          INTEGER PRIMARY KEY AUTOINCREMENT,
+	season      INTEGER NOT NULL,
+	started_at  TEXT    NOT NULL,
+	finished_at TEXT
+);
+`
+
+// Store is an embedded SQLite database holding synced records and the
+// watermarks recording how far each endpoint has been synced.
+type Store struct {
+	db *sql.DB
+}
+
+// Open opens or creates the store at path. Use ":memory:" for a throwaway
+// in-memory store.
+func Open(path string) (*Store, error) {
+	dsn := path
+	if path != ":memory:" {
+		dsn = "file:" + path +
+			"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)"
+	}
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("could not open store %s; %w", path, err)
+	}
+	// SQLite serializes writers anyway, and a single connection keeps an
+	// in-memory database alive for the lifetime of the store.
+	db.SetMaxOpenConns(1)
+
+	if _, err = db.Exec(schema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("could not create store schema; %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close closes the underlying database.
+func (s *Store) Close() error {
+	if err := s.db.Close(); err != nil {
+		return fmt.Errorf("could not close store; %w", err)
+	}
+
+	return nil
+}
+
+// Load returns every stored record of endpoint for season, ordered by season
+// type, week and key. T must match the endpoint's message type, e.g.
+// *cfbd.Play for EndpointPlays.
+func Load[T proto.Message](
+	ctx context.Context, s *Store, endpoint Endpoint, season int32,
+) ([]T, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT data FROM records
+		WHERE endpoint = ? AND season = ?
+		ORDER BY season_type, week, key`,
+		endpoint, season,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not query %s records; %w", endpoint, err)
+	}
+	defer rows.Close()
+
+	var zero T
+	var out []T
+	for rows.Next() {
+		var data []byte
+		if err = rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("could not read %s record; %w", endpoint, err)
+		}
+		m, ok := zero.ProtoReflect().New().Interface().(T)
+		if !ok {
+			return nil, fmt.Errorf("cannot load %s into %T", endpoint, zero)
+		}
+		if err = proto.Unmarshal(data, m); err != nil {
+			return nil, fmt.Errorf("could not decode %s record; %w", endpoint, err)
+		}
+		out = append(out, m)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read %s records; %w", endpoint, err)
+	}
+
+	return out, nil
+}
+
+// unit identifies the slice of an endpoint that is fetched and replaced
+// atomically: one week of a season type, or the whole season for ratings.
+type unit struct {
+	endpoint   Endpoint
+	season     int32
+	seasonType string
+	week       int32
+}
+
+// watermark returns the state recorded the last time u was synced.
+func (s *Store) watermark(ctx context.Context, u unit) (string, bool, error) {
+	var state string
+	err := s.db.QueryRowContext(ctx, `
+		SELECT state FROM watermarks
+		WHERE endpoint = ? AND season = ? AND season_type = ? AND week = ?`,
+		u.endpoint, u.season, u.seasonType, u.week,
+	).Scan(&state)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("could not read %s watermark; %w",
+			u.endpoint, err)
+	}
+
+	return state, true, nil
+}
+
+// replace swaps the stored records of u for records and advances its
+// watermark to state in a single transaction, so a crash leaves the unit
+// either fully synced or untouched. It returns the resulting changes.
+func (s *Store) replace(
+	ctx context.Context, u unit, state string, records []record, now time.Time,
+) ([]Change, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not begin %s transaction; %w",
+			u.endpoint, err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	existing, err := digests(ctx, tx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	stamp := now.UTC().Format(time.RFC3339)
+	var changes []Change
+	seen := make(map[string]bool, len(records))
+	for _, r := range records {
+		if seen[r.key] {
+			continue
+		}
+		seen[r.key] = true
+
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(r.msg)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode %s %s; %w",
+				u.endpoint, r.key, err)
+		}
+		sum := sha256.Sum256(data)
+
+		old, ok := existing[r.key]
+		if ok && bytes.Equal(old, sum[:]) {
+			continue
+		}
+		kind := Added
+		if ok {
+			kind = Changed
+		}
+
+		if _, err = tx.ExecContext(ctx, `
+			INSERT INTO records
+				(endpoint, season, season_type, week, key, digest, data, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (endpoint, season, season_type, week, key) DO UPDATE SET
+				digest = excluded.digest,
+				data = excluded.data,
+				updated_at = excluded.updated_at`,
+			u.endpoint, u.season, u.seasonType, u.week, r.key, sum[:], data, stamp,
+		); err != nil {
+			return nil, fmt.Errorf("could not write %s %s; %w",
+				u.endpoint, r.key, err)
+		}
+		changes = append(changes, u.change(kind, r.key))
+	}
+
+	for key := range existing {
+		if seen[key] {
+			continue
+		}
+		if _, err = tx.ExecContext(ctx, `
+			DELETE FROM records
+			WHERE endpoint = ? AND season = ? AND season_type = ? AND week = ?
+				AND key = ?`,
+			u.endpoint, u.season, u.seasonType, u.week, key,
+		); err != nil {
+			return nil, fmt.Errorf("could not delete %s %s; %w",
+				u.endpoint, key, err)
+		}
+		changes = append(changes, u.change(Removed, key))
+	}
+
+	if _, err = tx.ExecContext(ctx, `
+		INSERT INTO watermarks (endpoint, season, season_type, week, state, synced_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (endpoint, season, season_type, week) DO UPDATE SET
+			state = excluded.state,
+			synced_at = excluded.synced_at`,
+		u.endpoint, u.season, u.seasonType, u.week, state, stamp,
+	); err != nil {
+		return nil, fmt.Errorf("could not write %s watermark; %w",
+			u.endpoint, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("could not commit %s; %w", u.endpoint, err)
+	}
+
+	return changes, nil
+}
+
+func digests(
+	ctx context.Context, tx *sql.Tx, u unit,
+) (map[string][]byte, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT key, digest FROM records
+		WHERE endpoint = ? AND season = ? AND season_type = ? AND week = ?`,
+		u.endpoint, u.season, u.seasonType, u.week,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not query %s digests; %w", u.endpoint, err)
+	}
+	defer rows.Close()
+
+	out := make(map[string][]byte)
+	for rows.Next() {
+		var key string
+		var digest []byte
+		if err = rows.Scan(&key, &digest); err != nil {
+			return nil, fmt.Errorf("could not read %s digest; %w",
+				u.endpoint, err)
+		}
+		out[key] = digest
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read %s digests; %w", u.endpoint, err)
+	}
+
+	return out, nil
+}
+
+// startRun records the start of a sync of season and reports whether the
+// previous run of that season never finished.
+func (s *Store) startRun(
+	ctx context.Context, season int32, now time.Time,
+) (int64, bool, error) {
+	var unfinished bool
+	err := s.db.QueryRowContext(ctx, `
+		SELECT finished_at IS NULL FROM runs
+		WHERE season = ? ORDER BY id DESC LIMIT 1`,
+		season,
+	).Scan(&unfinished)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, false, fmt.Errorf("could not read previous run; %w", err)
+	}
+
+	result, err := s.db.ExecContext(ctx,
+		`INSERT INTO runs (season, started_at) VALUES (?, ?)`,
+		season, now.UTC().Format(time.RFC3339),
+	)
+	if err != nil {
+		return 0, false, fmt.Errorf("could not record run; %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, false, fmt.Errorf("could not record run; %w", err)
+	}
+
+	return id, unfinished, nil
+}
+
+func (s *Store) finishRun(ctx context.Context, id int64, now time.Time) error {
+	if _, err := s.db.ExecContext(ctx,
+		`UPDATE runs SET finished_at = ? WHERE id = ?`,
+		now.UTC().Format(time.RFC3339), id,
+	); err != nil {
+		return fmt.Errorf("could not finish run; %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package sync
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen_File_ShouldPersistAcrossReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "season.db")
+	store, err := Open(path)
+	require.NoError(t, err)
+	_, err = New(newFakeSource(), store, fixedClock(seasonEnd)).
+		Sync(context.Background(), testSeason)
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+
+	store, err = Open(path)
+	require.NoError(t, err)
+	defer store.Close()
+
+	games, err := Load[*cfbd.Game](context.Background(), store,
+		EndpointGames, testSeason)
+	require.NoError(t, err)
+	require.Len(t, games, 4)
+	assert.Equal(t, int32(100), games[0].GetId())
+
+	report, err := New(newFakeSource(), store, fixedClock(seasonEnd)).
+		Sync(context.Background(), testSeason)
+	require.NoError(t, err)
+	assert.Empty(t, report.Fetched)
+}
+
+func TestLoad_UnsyncedSeason_ShouldReturnEmpty(t *testing.T) {
+	games, err := Load[*cfbd.Game](context.Background(), openTestStore(t),
+		EndpointGames, testSeason)
+
+	require.NoError(t, err)
+	assert.Empty(t, games)
+}
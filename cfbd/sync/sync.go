@@ -0,0 +1,330 @@
+// Package sync keeps a season of CFBD data up to date in a local embedded
+// SQLite store. Each run fetches the season calendar and games, then only
+// refetches the weeks whose games changed completion state since the last
+// run, plus the current calendar week. Every endpoint week is written in its
+// own transaction together with its watermark, so an interrupted run resumes
+// where it stopped.
+package sync
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+// ChangeKind classifies a Change.
+type ChangeKind int
+
+const (
+	// Added records a key that was not stored before.
+	Added ChangeKind = iota
+	// Changed records a stored key whose content differs.
+	Changed
+	// Removed records a stored key the API no longer returns.
+	Removed
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Changed:
+		return "changed"
+	case Removed:
+		return "removed"
+	default:
+		return fmt.Sprintf("ChangeKind(%d)", int(k))
+	}
+}
+
+// Change is a single record added, changed or removed by a run.
+type Change struct {
+	Kind     ChangeKind
+	Endpoint Endpoint
+	Season   int32
+	// SeasonType and Week are empty for season-level endpoints such as
+	// ratings.
+	SeasonType string
+	Week       int32
+	// Key identifies the record within its endpoint, e.g. a game or play ID.
+	Key string
+}
+
+func (u unit) change(kind ChangeKind, key string) Change {
+	return Change{
+		Kind:       kind,
+		Endpoint:   u.endpoint,
+		Season:     u.season,
+		SeasonType: u.seasonType,
+		Week:       u.week,
+		Key:        key,
+	}
+}
+
+// Reason explains why a run refetched an endpoint week.
+type Reason string
+
+const (
+	// ReasonNew means the endpoint week had never been synced.
+	ReasonNew Reason = "never synced"
+	// ReasonCompletion means a game in the week changed completion state,
+	// or for season-level endpoints, any game in the season did.
+	ReasonCompletion Reason = "completion changed"
+	// ReasonCurrent means the week is the current calendar week.
+	ReasonCurrent Reason = "current week"
+)
+
+// Fetch is an endpoint week refetched by a run. Games are fetched for the
+// whole season on every run and are not listed.
+type Fetch struct {
+	Endpoint   Endpoint
+	SeasonType string
+	Week       int32
+	Reason     Reason
+}
+
+// Report describes what a run fetched and changed.
+type Report struct {
+	Season int32
+	// Resumed is set when the previous run of the season did not finish.
+	Resumed bool
+	Fetched []Fetch
+	Changes []Change
+}
+
+// Count returns the number of changes of kind.
+func (r *Report) Count(kind ChangeKind) int {
+	var n int
+	for _, c := range r.Changes {
+		if c.Kind == kind {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Option configures a Syncer.
+type Option func(*Syncer)
+
+// WithClock overrides the clock used to find the current calendar week and
+// to stamp watermarks.
+func WithClock(now func() time.Time) Option {
+	return func(s *Syncer) {
+		s.now = now
+	}
+}
+
+// Syncer syncs seasons from a Source into a Store.
+type Syncer struct {
+	src   Source
+	store *Store
+	now   func() time.Time
+}
+
+// New creates a Syncer. src is usually a *cfbd.Client.
+func New(src Source, store *Store, opts ...Option) *Syncer {
+	s := &Syncer{src: src, store: store, now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// week is one calendar week of a season type with the games played in it.
+type week struct {
+	seasonType string
+	number     int32
+	current    bool
+	games      []*cfbd.Game
+}
+
+// Sync brings season up to date. On error the returned report still lists
+// everything committed before the failure, and the next run resumes from
+// there.
+func (s *Syncer) Sync(ctx context.Context, season int32) (*Report, error) {
+	report := &Report{Season: season}
+	runID, resumed, err := s.store.startRun(ctx, season, s.now())
+	if err != nil {
+		return report, err
+	}
+	report.Resumed = resumed
+
+	weeks, err := s.weeks(ctx, season)
+	if err != nil {
+		return report, err
+	}
+
+	states := make([]string, 0, len(weeks))
+	for _, w := range weeks {
+		state := completionState(w.games)
+		states = append(states,
+			fmt.Sprintf("%s/%d/%s", w.seasonType, w.number, state))
+
+		games := unit{EndpointGames, season, w.seasonType, w.number}
+		changes, err := s.store.replace(ctx, games, state,
+			records(w.games, func(g *cfbd.Game) string {
+				return gameKey(g.GetId())
+			}), s.now())
+		if err != nil {
+			return report, err
+		}
+		report.Changes = append(report.Changes, changes...)
+
+		for _, e := range weekly {
+			u := unit{e.endpoint, season, w.seasonType, w.number}
+			err = s.syncUnit(ctx, report, u, e.fetch, state, w.current)
+			if err != nil {
+				return report, err
+			}
+		}
+	}
+
+	state := digest(states)
+	for _, e := range seasonal {
+		u := unit{e.endpoint, season, "", 0}
+		if err = s.syncUnit(ctx, report, u, e.fetch, state, false); err != nil {
+			return report, err
+		}
+	}
+
+	return report, s.store.finishRun(ctx, runID, s.now())
+}
+
+// syncUnit refetches u when it is stale and records the outcome in report.
+func (s *Syncer) syncUnit(
+	ctx context.Context,
+	report *Report,
+	u unit,
+	fetch fetcher,
+	state string,
+	current bool,
+) error {
+	synced, ok, err := s.store.watermark(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	var reason Reason
+	switch {
+	case !ok:
+		reason = ReasonNew
+	case synced != state:
+		reason = ReasonCompletion
+	case current:
+		reason = ReasonCurrent
+	default:
+		return nil
+	}
+
+	recs, err := fetch(ctx, s.src, u)
+	if err != nil {
+		return fmt.Errorf("could not sync %s %d %s week %d; %w",
+			u.endpoint, u.season, u.seasonType, u.week, err)
+	}
+	changes, err := s.store.replace(ctx, u, state, recs, s.now())
+	if err != nil {
+		return err
+	}
+
+	report.Fetched = append(report.Fetched, Fetch{
+		Endpoint:   u.endpoint,
+		SeasonType: u.seasonType,
+		Week:       u.week,
+		Reason:     reason,
+	})
+	report.Changes = append(report.Changes, changes...)
+
+	return nil
+}
+
+// weeks fetches the calendar and games of season and groups the games by
+// season type and week. Weeks with games but no calendar entry are kept.
+func (s *Syncer) weeks(ctx context.Context, season int32) ([]*week, error) {
+	calendar, err := s.src.GetCalendar(ctx, cfbd.GetCalendarRequest{
+		Year: season,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not sync %d calendar; %w", season, err)
+	}
+
+	now := s.now()
+	var weeks []*week
+	index := make(map[string]*week)
+	add := func(seasonType string, number int32) *week {
+		key := fmt.Sprintf("%s/%d", seasonType, number)
+		w, ok := index[key]
+		if !ok {
+			w = &week{seasonType: seasonType, number: number}
+			index[key] = w
+			weeks = append(weeks, w)
+		}
+		return w
+	}
+
+	var seasonTypes []string
+	for _, cw := range calendar {
+		w := add(cw.GetSeasonType(), cw.GetWeek())
+		start, end := cw.GetStartDate().AsTime(), cw.GetEndDate().AsTime()
+		if cw.GetStartDate() != nil && cw.GetEndDate() != nil &&
+			!now.Before(start) && now.Before(end) {
+			w.current = true
+		}
+		if !slices.Contains(seasonTypes, cw.GetSeasonType()) {
+			seasonTypes = append(seasonTypes, cw.GetSeasonType())
+		}
+	}
+
+	for _, seasonType := range seasonTypes {
+		games, err := s.src.GetGames(ctx, cfbd.GetGamesRequest{
+			Year: season, SeasonType: seasonType,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not sync %d %s games; %w",
+				season, seasonType, err)
+		}
+		for _, g := range games {
+			w := add(seasonType, g.GetWeek())
+			w.games = append(w.games, g)
+		}
+	}
+
+	slices.SortStableFunc(weeks, func(a, b *week) int {
+		return cmp.Or(
+			cmp.Compare(
+				slices.Index(seasonTypes, a.seasonType),
+				slices.Index(seasonTypes, b.seasonType),
+			),
+			cmp.Compare(a.number, b.number),
+		)
+	})
+
+	return weeks, nil
+}
+
+// completionState summarizes which games of a week are completed, so that a
+// week is refetched exactly when a game is added, removed or completed.
+func completionState(games []*cfbd.Game) string {
+	entries := make([]string, 0, len(games))
+	for _, g := range games {
+		entries = append(entries,
+			fmt.Sprintf("%d:%t", g.GetId(), g.GetCompleted()))
+	}
+	slices.Sort(entries)
+
+	return digest(entries)
+}
+
+func digest(entries []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(entries, "\n")))
+
+	return hex.EncodeToString(sum[:8])
+}
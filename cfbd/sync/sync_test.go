@@ -0,0 +1,350 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testSeason = 2024
+
+var (
+	week1Start = time.Date(2024, 8, 25, 0, 0, 0, 0, time.UTC)
+	week2Start = week1Start.AddDate(0, 0, 7)
+	seasonEnd  = week2Start.AddDate(0, 0, 7)
+)
+
+var errFakeOutage = errors.New("fake outage")
+
+// fakeSource serves a two week season derived from its games and plays.
+type fakeSource struct {
+	games []*cfbd.Game
+	plays []*cfbd.Play
+	// failPlaysWeek makes GetPlays fail for that week when set.
+	failPlaysWeek int32
+	calls         map[string]int
+}
+
+func newFakeSource() *fakeSource {
+	src := &fakeSource{calls: make(map[string]int)}
+	for i, week := range []int32{1, 1, 2, 2} {
+		id := int32(100 + i)
+		src.games = append(src.games, &cfbd.Game{
+			Id:         id,
+			Season:     testSeason,
+			Week:       week,
+			SeasonType: "regular",
+			Completed:  week == 1,
+		})
+		src.plays = append(src.plays,
+			&cfbd.Play{Id: fmt.Sprintf("%d01", id), GameId: id},
+			&cfbd.Play{Id: fmt.Sprintf("%d02", id), GameId: id},
+		)
+	}
+
+	return src
+}
+
+func (f *fakeSource) game(id int32) *cfbd.Game {
+	for _, g := range f.games {
+		if g.GetId() == id {
+			return g
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeSource) inWeek(week int32) []*cfbd.Game {
+	var out []*cfbd.Game
+	for _, g := range f.games {
+		if g.GetWeek() == week {
+			out = append(out, g)
+		}
+	}
+
+	return out
+}
+
+func (f *fakeSource) GetCalendar(
+	_ context.Context, _ cfbd.GetCalendarRequest,
+) ([]*cfbd.CalendarWeek, error) {
+	f.calls["calendar"]++
+	return []*cfbd.CalendarWeek{
+		{
+			Season: testSeason, Week: 1, SeasonType: "regular",
+			StartDate: timestamppb.New(week1Start),
+			EndDate:   timestamppb.New(week2Start),
+		},
+		{
+			Season: testSeason, Week: 2, SeasonType: "regular",
+			StartDate: timestamppb.New(week2Start),
+			EndDate:   timestamppb.New(seasonEnd),
+		},
+	}, nil
+}
+
+func (f *fakeSource) GetGames(
+	_ context.Context, _ cfbd.GetGamesRequest,
+) ([]*cfbd.Game, error) {
+	f.calls["games"]++
+	return f.games, nil
+}
+
+func (f *fakeSource) GetDrives(
+	_ context.Context, request cfbd.GetDrivesRequest,
+) ([]*cfbd.Drive, error) {
+	f.calls["drives"]++
+	var out []*cfbd.Drive
+	for _, g := range f.inWeek(request.Week) {
+		out = append(out, &cfbd.Drive{
+			Id: fmt.Sprintf("%d1", g.GetId()), GameId: g.GetId(),
+		})
+	}
+
+	return out, nil
+}
+
+func (f *fakeSource) GetPlays(
+	_ context.Context, request cfbd.GetPlaysRequest,
+) ([]*cfbd.Play, error) {
+	f.calls["plays"]++
+	if f.failPlaysWeek == request.Week {
+		return nil, errFakeOutage
+	}
+
+	var out []*cfbd.Play
+	for _, p := range f.plays {
+		if f.game(p.GetGameId()).GetWeek() == request.Week {
+			out = append(out, p)
+		}
+	}
+
+	return out, nil
+}
+
+func (f *fakeSource) GetGameTeams(
+	_ context.Context, request cfbd.GetGameTeamsRequest,
+) ([]*cfbd.GameTeamStats, error) {
+	f.calls["games/teams"]++
+	var out []*cfbd.GameTeamStats
+	for _, g := range f.inWeek(request.Week) {
+		out = append(out, &cfbd.GameTeamStats{Id: g.GetId()})
+	}
+
+	return out, nil
+}
+
+func (f *fakeSource) GetGamePlayers(
+	_ context.Context, request cfbd.GetGamePlayersRequest,
+) ([]*cfbd.GamePlayerStats, error) {
+	f.calls["games/players"]++
+	var out []*cfbd.GamePlayerStats
+	for _, g := range f.inWeek(request.Week) {
+		out = append(out, &cfbd.GamePlayerStats{Id: g.GetId()})
+	}
+
+	return out, nil
+}
+
+func (f *fakeSource) GetBettingLines(
+	_ context.Context, request cfbd.GetBettingLinesRequest,
+) ([]*cfbd.BettingGame, error) {
+	f.calls["lines"]++
+	var out []*cfbd.BettingGame
+	for _, g := range f.inWeek(request.Week) {
+		out = append(out, &cfbd.BettingGame{
+			Id: g.GetId(), Week: g.GetWeek(),
+		})
+	}
+
+	return out, nil
+}
+
+func (f *fakeSource) GetRankings(
+	_ context.Context, request cfbd.GetRankingsRequest,
+) ([]*cfbd.PollWeek, error) {
+	f.calls["rankings"]++
+	return []*cfbd.PollWeek{{
+		Season:     testSeason,
+		SeasonType: request.SeasonType,
+		Week:       int32(request.Week),
+	}}, nil
+}
+
+func (f *fakeSource) GetTeamSPPlusRatings(
+	_ context.Context, _ cfbd.GetSPPlusRatingsRequest,
+) ([]*cfbd.TeamSP, error) {
+	f.calls["ratings/sp"]++
+	return []*cfbd.TeamSP{{Year: testSeason, Team: "Georgia"}}, nil
+}
+
+func (f *fakeSource) GetSRSRatings(
+	_ context.Context, _ cfbd.GetSRSRatingsRequest,
+) ([]*cfbd.TeamSRS, error) {
+	f.calls["ratings/srs"]++
+	return []*cfbd.TeamSRS{{Year: testSeason, Team: "Georgia"}}, nil
+}
+
+func (f *fakeSource) GetEloRatings(
+	_ context.Context, _ cfbd.GetEloRatingsRequest,
+) ([]*cfbd.TeamElo, error) {
+	f.calls["ratings/elo"]++
+	return []*cfbd.TeamElo{{Year: testSeason, Team: "Georgia"}}, nil
+}
+
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+	store, err := Open(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+
+	return store
+}
+
+func fixedClock(now time.Time) Option {
+	return WithClock(func() time.Time { return now })
+}
+
+func fetchedWeeks(report *Report, endpoint Endpoint) []int32 {
+	var weeks []int32
+	for _, f := range report.Fetched {
+		if f.Endpoint == endpoint {
+			weeks = append(weeks, f.Week)
+		}
+	}
+
+	return weeks
+}
+
+func TestSync_FirstRun_ShouldFetchAndAddEverything(t *testing.T) {
+	src := newFakeSource()
+	store := openTestStore(t)
+
+	report, err := New(src, store, fixedClock(seasonEnd)).
+		Sync(context.Background(), testSeason)
+	require.NoError(t, err)
+
+	assert.False(t, report.Resumed)
+	assert.Len(t, report.Fetched, 2*len(weekly)+len(seasonal))
+	for _, f := range report.Fetched {
+		assert.Equal(t, ReasonNew, f.Reason)
+	}
+	assert.Equal(t, []int32{1, 2}, fetchedWeeks(report, EndpointPlays))
+	assert.Equal(t, []int32{0}, fetchedWeeks(report, EndpointEloRatings))
+	assert.Zero(t, report.Count(Changed))
+	assert.Zero(t, report.Count(Removed))
+	// 4 games, drives, plays x2, team stats, player stats and lines, 2 poll
+	// weeks and 3 ratings.
+	assert.Equal(t, 4*7+2+3, report.Count(Added))
+
+	plays, err := Load[*cfbd.Play](context.Background(), store,
+		EndpointPlays, testSeason)
+	require.NoError(t, err)
+	assert.Len(t, plays, len(src.plays))
+}
+
+func TestSync_UnchangedSeason_ShouldFetchNothing(t *testing.T) {
+	src := newFakeSource()
+	syncer := New(src, openTestStore(t), fixedClock(seasonEnd))
+	_, err := syncer.Sync(context.Background(), testSeason)
+	require.NoError(t, err)
+
+	report, err := syncer.Sync(context.Background(), testSeason)
+	require.NoError(t, err)
+
+	assert.False(t, report.Resumed)
+	assert.Empty(t, report.Fetched)
+	assert.Empty(t, report.Changes)
+	assert.Equal(t, 2, src.calls["games"])
+	assert.Equal(t, 2, src.calls["plays"])
+}
+
+func TestSync_GameCompleted_ShouldRefetchOnlyThatWeek(t *testing.T) {
+	src := newFakeSource()
+	store := openTestStore(t)
+	syncer := New(src, store, fixedClock(seasonEnd))
+	_, err := syncer.Sync(context.Background(), testSeason)
+	require.NoError(t, err)
+
+	src.game(102).Completed = true
+	src.plays[4].PlayText = "Corrected play text."
+	src.plays = append(src.plays[:5], src.plays[6:]...)
+	src.plays = append(src.plays, &cfbd.Play{Id: "10203", GameId: 102})
+
+	report, err := syncer.Sync(context.Background(), testSeason)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int32{2}, fetchedWeeks(report, EndpointPlays))
+	assert.Equal(t, []int32{2}, fetchedWeeks(report, EndpointLines))
+	assert.Equal(t, []int32{0}, fetchedWeeks(report, EndpointSPRatings))
+	for _, f := range report.Fetched {
+		assert.Equal(t, ReasonCompletion, f.Reason)
+	}
+	assert.ElementsMatch(t, []Change{
+		{Changed, EndpointGames, testSeason, "regular", 2, "102"},
+		{Changed, EndpointPlays, testSeason, "regular", 2, "10201"},
+		{Removed, EndpointPlays, testSeason, "regular", 2, "10202"},
+		{Added, EndpointPlays, testSeason, "regular", 2, "10203"},
+	}, report.Changes)
+
+	plays, err := Load[*cfbd.Play](context.Background(), store,
+		EndpointPlays, testSeason)
+	require.NoError(t, err)
+	assert.Len(t, plays, len(src.plays))
+}
+
+func TestSync_CurrentWeek_ShouldAlwaysRefetch(t *testing.T) {
+	src := newFakeSource()
+	syncer := New(src, openTestStore(t),
+		fixedClock(week2Start.Add(36*time.Hour)))
+	_, err := syncer.Sync(context.Background(), testSeason)
+	require.NoError(t, err)
+
+	report, err := syncer.Sync(context.Background(), testSeason)
+	require.NoError(t, err)
+
+	assert.Len(t, report.Fetched, len(weekly))
+	for _, f := range report.Fetched {
+		assert.Equal(t, int32(2), f.Week)
+		assert.Equal(t, ReasonCurrent, f.Reason)
+	}
+	assert.Empty(t, report.Changes)
+}
+
+func TestSync_InterruptedRun_ShouldResumeWhereItStopped(t *testing.T) {
+	src := newFakeSource()
+	src.failPlaysWeek = 2
+	syncer := New(src, openTestStore(t), fixedClock(seasonEnd))
+
+	report, err := syncer.Sync(context.Background(), testSeason)
+	require.ErrorIs(t, err, errFakeOutage)
+	assert.Equal(t, []int32{1, 2}, fetchedWeeks(report, EndpointDrives))
+	assert.Equal(t, []int32{1}, fetchedWeeks(report, EndpointPlays))
+
+	src.failPlaysWeek = 0
+	report, err = syncer.Sync(context.Background(), testSeason)
+	require.NoError(t, err)
+
+	assert.True(t, report.Resumed)
+	assert.Empty(t, fetchedWeeks(report, EndpointDrives))
+	assert.Equal(t, []int32{2}, fetchedWeeks(report, EndpointPlays))
+	assert.Equal(t, []int32{2}, fetchedWeeks(report, EndpointRankings))
+	assert.Equal(t, []int32{0}, fetchedWeeks(report, EndpointSRSRatings))
+	// Week 2 plays x4, team stats, player stats and lines x2, a poll week
+	// and 3 ratings.
+	assert.Equal(t, 4+3*2+1+3, report.Count(Added))
+	assert.Len(t, report.Changes, report.Count(Added))
+
+	report, err = syncer.Sync(context.Background(), testSeason)
+	require.NoError(t, err)
+	assert.False(t, report.Resumed)
+	assert.Empty(t, report.Fetched)
+}
@@ -0,0 +1,66 @@
+package teams
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type cachedSeason struct {
+	Season int32             `json:"season"`
+	Teams  []json.RawMessage `json:"teams"`
+}
+
+type cache struct {
+	Seasons []cachedSeason `json:"seasons"`
+}
+
+// Save writes the seasons the resolver was built from as JSON, so that a
+// resolver can be rebuilt offline with Load. Options are not saved.
+func (r *TeamResolver) Save(w io.Writer) error {
+	var c cache
+	for _, s := range r.Seasons() {
+		cs := cachedSeason{Season: s.Season}
+		for _, team := range s.Teams {
+			b, err := protojson.Marshal(team)
+			if err != nil {
+				return fmt.Errorf("team %d; %w", team.GetId(), err)
+			}
+			cs.Teams = append(cs.Teams, b)
+		}
+		c.Seasons = append(c.Seasons, cs)
+	}
+
+	if err := json.NewEncoder(w).Encode(c); err != nil {
+		return fmt.Errorf("could not write team cache; %w", err)
+	}
+
+	return nil
+}
+
+// Load reads a resolver written by Save.
+func Load(rd io.Reader, opts ...Option) (*TeamResolver, error) {
+	var c cache
+	if err := json.NewDecoder(rd).Decode(&c); err != nil {
+		return nil, fmt.Errorf("could not read team cache; %w", err)
+	}
+
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	seasons := make([]Season, 0, len(c.Seasons))
+	for _, cs := range c.Seasons {
+		s := Season{Season: cs.Season}
+		for _, raw := range cs.Teams {
+			team := &cfbd.Team{}
+			if err := unmarshal.Unmarshal(raw, team); err != nil {
+				return nil, fmt.Errorf("season %d; %w", cs.Season, err)
+			}
+			s.Teams = append(s.Teams, team)
+		}
+		seasons = append(seasons, s)
+	}
+
+	return NewTeamResolver(seasons, opts...), nil
+}
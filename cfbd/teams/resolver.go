@@ -0,0 +1,431 @@
+// Package teams resolves the many spellings of a school used across CFBD
+// endpoints, such as Game.home_team, Recruit.committed_to or
+// DraftPick.college_team, to the canonical Team returned by GetTeams.
+package teams
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+var (
+	// ErrUnresolved is returned when a name matches no team.
+	ErrUnresolved = errors.New("unresolved team")
+	// ErrAmbiguous is returned when a name matches more than one team
+	// equally well.
+	ErrAmbiguous = errors.New("ambiguous team")
+)
+
+// Source is the subset of *cfbd.Client used to build a TeamResolver.
+type Source interface {
+	GetTeams(
+		ctx context.Context, request cfbd.GetTeamsRequest,
+	) ([]*cfbd.Team, error)
+}
+
+var _ Source = (*cfbd.Client)(nil)
+
+// Season is the list of teams GetTeams returned for one season.
+type Season struct {
+	Season int32
+	Teams  []*cfbd.Team
+}
+
+// Option configures a TeamResolver.
+type Option func(*TeamResolver)
+
+// WithAlias resolves name to the team with the given ID in every season.
+// Aliases take precedence over names derived from the teams themselves.
+func WithAlias(name string, teamID int32) Option {
+	return func(r *TeamResolver) {
+		r.aliases[normalize(name)] = teamID
+	}
+}
+
+// WithMaxDistance sets how many single character edits a name may be from
+// a known spelling and still resolve. The default is 2; 0 disables fuzzy
+// matching.
+func WithMaxDistance(edits int) Option {
+	return func(r *TeamResolver) {
+		r.maxDistance = edits
+	}
+}
+
+// TeamResolver maps team names, abbreviations, alternate names and IDs to
+// a canonical Team. It is safe for concurrent use.
+type TeamResolver struct {
+	seasons     []*index
+	aliases     map[string]int32
+	maxDistance int
+
+	mu         sync.Mutex
+	unresolved map[string]int
+}
+
+// NewTeamResolver builds a resolver from one or more seasons of teams. A
+// season is used for lookups in that season and later ones, until a newer
+// season is given, so that conference realignment is reflected.
+func NewTeamResolver(seasons []Season, opts ...Option) *TeamResolver {
+	r := &TeamResolver{
+		aliases:     make(map[string]int32),
+		maxDistance: 2,
+		unresolved:  make(map[string]int),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	for _, s := range seasons {
+		r.seasons = append(r.seasons, newIndex(s))
+	}
+	slices.SortFunc(r.seasons, func(a, b *index) int {
+		return int(a.season) - int(b.season)
+	})
+
+	return r
+}
+
+// Build fetches teams for each season from src and returns a resolver over
+// them.
+func Build(
+	ctx context.Context, src Source, seasons []int32, opts ...Option,
+) (*TeamResolver, error) {
+	out := make([]Season, 0, len(seasons))
+	for _, season := range seasons {
+		teams, err := src.GetTeams(ctx, cfbd.GetTeamsRequest{Year: season})
+		if err != nil {
+			return nil, fmt.Errorf("teams %d; %w", season, err)
+		}
+		out = append(out, Season{Season: season, Teams: teams})
+	}
+
+	return NewTeamResolver(out, opts...), nil
+}
+
+// Seasons returns the seasons the resolver was built from, oldest first.
+func (r *TeamResolver) Seasons() []Season {
+	out := make([]Season, 0, len(r.seasons))
+	for _, idx := range r.seasons {
+		out = append(out, Season{Season: idx.season, Teams: idx.teams})
+	}
+
+	return out
+}
+
+// Resolve looks up name in the latest season.
+func (r *TeamResolver) Resolve(name string) (*cfbd.Team, error) {
+	if len(r.seasons) == 0 {
+		return nil, r.miss(name, ErrUnresolved)
+	}
+
+	return r.ResolveIn(r.seasons[len(r.seasons)-1].season, name)
+}
+
+// ResolveIn looks up name as of season. The team is returned as listed for
+// the latest season at or before season, falling back to the nearest other
+// season for schools that were not listed then. Failed lookups are recorded
+// for Unresolved.
+func (r *TeamResolver) ResolveIn(
+	season int32, name string,
+) (*cfbd.Team, error) {
+	key := normalize(name)
+	if key == "" {
+		return nil, r.miss(name, ErrUnresolved)
+	}
+
+	// Exact spellings in any season beat fuzzy matches in the nearest one.
+	indexes := r.nearest(season)
+	for _, fuzzy := range []bool{false, true} {
+		for _, idx := range indexes {
+			team, err := r.lookup(idx, key, fuzzy)
+			if err == nil {
+				return team, nil
+			}
+			if errors.Is(err, ErrAmbiguous) {
+				return nil, r.miss(name, err)
+			}
+		}
+	}
+
+	return nil, r.miss(name, ErrUnresolved)
+}
+
+// ResolveID looks up a team by ID as of season.
+func (r *TeamResolver) ResolveID(season, id int32) (*cfbd.Team, error) {
+	return r.ResolveIn(season, strconv.Itoa(int(id)))
+}
+
+// ResolveAll resolves every name as of season and returns the resolved
+// teams keyed by the name as given, plus the names that failed.
+func (r *TeamResolver) ResolveAll(
+	season int32, names []string,
+) (map[string]*cfbd.Team, []string) {
+	out := make(map[string]*cfbd.Team, len(names))
+	var failed []string
+	for _, name := range names {
+		if _, ok := out[name]; ok {
+			continue
+		}
+		team, err := r.ResolveIn(season, name)
+		if err != nil {
+			if !slices.Contains(failed, name) {
+				failed = append(failed, name)
+			}
+			continue
+		}
+		out[name] = team
+	}
+
+	return out, failed
+}
+
+// Unresolved returns every name that failed to resolve so far with the
+// number of failed lookups.
+func (r *TeamResolver) Unresolved() map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return maps.Clone(r.unresolved)
+}
+
+func (r *TeamResolver) miss(name string, err error) error {
+	r.mu.Lock()
+	r.unresolved[name]++
+	r.mu.Unlock()
+
+	return fmt.Errorf("%q; %w", name, err)
+}
+
+// nearest orders the indexes to search for season: the latest at or before
+// it first, then the rest by distance.
+func (r *TeamResolver) nearest(season int32) []*index {
+	out := slices.Clone(r.seasons)
+	slices.SortStableFunc(out, func(a, b *index) int {
+		return distanceTo(a.season, season) - distanceTo(b.season, season)
+	})
+
+	return out
+}
+
+// distanceTo ranks earlier seasons ahead of later ones at the same
+// distance, because a season's teams apply until the next one.
+func distanceTo(season, target int32) int {
+	d := int(target - season)
+	if d >= 0 {
+		return 2 * d
+	}
+
+	return -2*d + 1
+}
+
+func (r *TeamResolver) lookup(
+	idx *index, key string, fuzzy bool,
+) (*cfbd.Team, error) {
+	if fuzzy {
+		return idx.fuzzy(key, r.maxDistance)
+	}
+
+	if id, ok := r.aliases[key]; ok {
+		if team, ok := idx.byID[id]; ok {
+			return team, nil
+		}
+	}
+
+	if e, ok := idx.keys[key]; ok {
+		if e.ambiguous {
+			return nil, ErrAmbiguous
+		}
+		return idx.byID[e.id], nil
+	}
+
+	return nil, ErrUnresolved
+}
+
+// priority orders the ways a key can name a team; on a collision the
+// higher priority wins, and equal priorities make the key ambiguous.
+type priority int
+
+const (
+	priorityMascot priority = iota
+	priorityAbbreviation
+	priorityAlternate
+	prioritySchool
+	priorityID
+)
+
+type entry struct {
+	id        int32
+	priority  priority
+	ambiguous bool
+}
+
+type index struct {
+	season int32
+	teams  []*cfbd.Team
+	byID   map[int32]*cfbd.Team
+	keys   map[string]entry
+}
+
+func newIndex(s Season) *index {
+	idx := &index{
+		season: s.Season,
+		teams:  s.Teams,
+		byID:   make(map[int32]*cfbd.Team, len(s.Teams)),
+		keys:   make(map[string]entry),
+	}
+	for _, team := range s.Teams {
+		idx.byID[team.GetId()] = team
+		idx.add(strconv.Itoa(int(team.GetId())), team, priorityID)
+		idx.add(team.GetSchool(), team, prioritySchool)
+		for _, alt := range team.GetAlternateNames() {
+			idx.add(alt, team, priorityAlternate)
+		}
+		idx.add(team.GetAbbreviation(), team, priorityAbbreviation)
+		if team.GetMascot() != "" {
+			idx.add(team.GetSchool()+" "+team.GetMascot(), team, priorityMascot)
+		}
+	}
+
+	return idx
+}
+
+func (idx *index) add(name string, team *cfbd.Team, p priority) {
+	key := normalize(name)
+	if key == "" {
+		return
+	}
+
+	e, ok := idx.keys[key]
+	switch {
+	case !ok, p > e.priority:
+		idx.keys[key] = entry{id: team.GetId(), priority: p}
+	case p == e.priority && e.id != team.GetId():
+		e.ambiguous = true
+		idx.keys[key] = e
+	}
+}
+
+// fuzzy finds the single team whose spellings are closest to key within
+// maxDistance edits. Short keys such as abbreviations must match exactly.
+func (idx *index) fuzzy(key string, maxDistance int) (*cfbd.Team, error) {
+	if maxDistance <= 0 || len(key) < 5 {
+		return nil, ErrUnresolved
+	}
+
+	best := maxDistance + 1
+	var ids []int32
+	for k, e := range idx.keys {
+		if e.ambiguous || e.priority == priorityID || len(k) < 5 {
+			continue
+		}
+		d := levenshtein(key, k, best+1)
+		switch {
+		case d < best:
+			best, ids = d, []int32{e.id}
+		case d == best && !slices.Contains(ids, e.id):
+			ids = append(ids, e.id)
+		}
+	}
+
+	switch {
+	case best > maxDistance:
+		return nil, ErrUnresolved
+	case len(ids) > 1:
+		return nil, ErrAmbiguous
+	}
+
+	return idx.byID[ids[0]], nil
+}
+
+// levenshtein returns the edit distance between a and b, or limit once it
+// is clear the distance is at least limit.
+func levenshtein(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if abs(len(ra)-len(rb)) >= limit {
+		return limit
+	}
+
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin >= limit {
+			return limit
+		}
+		prev, cur = cur, prev
+	}
+
+	return min(prev[len(rb)], limit)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
+
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ñ", "n", "ç", "c",
+)
+
+// tokens rewrites common variations so that, for example, "Mississippi
+// St." and "Mississippi State" or "Texas A&M" and "Texas A and M" share a
+// key. Filler words are dropped.
+var tokens = map[string]string{
+	"st":         "state",
+	"univ":       "",
+	"university": "",
+	"of":         "",
+	"the":        "",
+	"and":        "&",
+}
+
+// normalize lower-cases name, folds accents and punctuation and rewrites
+// common tokens.
+func normalize(name string) string {
+	s := accents.Replace(strings.ToLower(name))
+	s = strings.ReplaceAll(s, "&", " & ")
+
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '&'
+	})
+	out := fields[:0]
+	for _, f := range fields {
+		if t, ok := tokens[f]; ok {
+			f = t
+		}
+		if f != "" {
+			out = append(out, f)
+		}
+	}
+
+	return strings.Join(out, " ")
+}
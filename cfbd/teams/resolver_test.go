@@ -0,0 +1,213 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/clintrovert/cfbd-go/cfbd/internal/test/fixture"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func loadTeams(t *testing.T) []*cfbd.Team {
+	return fixture.Load(t, "teams.json",
+		func() *cfbd.Team { return &cfbd.Team{} })
+}
+
+// realigned returns the fixture teams as they were before Texas, Oklahoma
+// and a few others joined the SEC.
+func realigned(teams []*cfbd.Team) []*cfbd.Team {
+	out := make([]*cfbd.Team, 0, len(teams))
+	for _, team := range teams {
+		team = proto.Clone(team).(*cfbd.Team)
+		if team.GetSchool() == "Texas" || team.GetSchool() == "Oklahoma" {
+			team.Conference = "Big 12"
+		}
+		out = append(out, team)
+	}
+
+	return out
+}
+
+func TestResolve_FixtureSpellings_ShouldFindCanonicalTeam(t *testing.T) {
+	r := NewTeamResolver([]Season{{Season: 2025, Teams: loadTeams(t)}})
+
+	for name, want := range map[string]string{
+		"Texas":                "Texas",
+		"TEX":                  "Texas",
+		"tex":                  "Texas",
+		"251":                  "Texas",
+		"Louisiana State":      "LSU",
+		"Mississippi St":       "Mississippi State",
+		"Mississippi St.":      "Mississippi State",
+		"Texas A and M":        "Texas A&M",
+		"TA&M":                 "Texas A&M",
+		"Alabama Crimson Tide": "Alabama",
+		"University of Texas":  "Texas",
+		"Vandebilt":            "Vanderbilt",
+		"  ole   miss ":        "Ole Miss",
+	} {
+		team, err := r.Resolve(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, team.GetSchool(), name)
+	}
+	assert.Empty(t, r.Unresolved())
+}
+
+func TestResolve_Unknown_ShouldReportUnresolved(t *testing.T) {
+	r := NewTeamResolver([]Season{{Season: 2025, Teams: loadTeams(t)}})
+
+	_, err := r.Resolve("Georgia Tech")
+	require.ErrorIs(t, err, ErrUnresolved)
+	assert.ErrorContains(t, err, "Georgia Tech")
+	_, err = r.Resolve("Georgia Tech")
+	require.Error(t, err)
+	_, err = r.Resolve("")
+	require.Error(t, err)
+
+	assert.Equal(t, map[string]int{"Georgia Tech": 2, "": 1}, r.Unresolved())
+}
+
+func TestResolveIn_Realignment_ShouldUseSeasonConference(t *testing.T) {
+	teams := loadTeams(t)
+	r := NewTeamResolver([]Season{
+		{Season: 2024, Teams: teams},
+		{Season: 2022, Teams: realigned(teams)},
+	})
+
+	for season, want := range map[int32]string{
+		2020: "Big 12",
+		2022: "Big 12",
+		2023: "Big 12",
+		2024: "SEC",
+		2026: "SEC",
+	} {
+		team, err := r.ResolveIn(season, "Texas")
+		require.NoError(t, err, season)
+		assert.Equal(t, want, team.GetConference(), season)
+	}
+
+	team, err := r.ResolveID(2022, 201)
+	require.NoError(t, err)
+	assert.Equal(t, "Oklahoma", team.GetSchool())
+	assert.Equal(t, "Big 12", team.GetConference())
+}
+
+func TestResolveIn_MissingFromSeason_ShouldFallBackToNearest(t *testing.T) {
+	teams := loadTeams(t)
+	r := NewTeamResolver([]Season{
+		{Season: 2020, Teams: teams[:1]},
+		{Season: 2024, Teams: teams},
+	})
+
+	team, err := r.ResolveIn(2020, "Vanderbilt")
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(238), team.GetId())
+}
+
+func TestResolve_SharedAbbreviation_ShouldBeAmbiguous(t *testing.T) {
+	r := NewTeamResolver([]Season{{Season: 2025, Teams: []*cfbd.Team{
+		{Id: 1, School: "Miami", Abbreviation: "MIA"},
+		{Id: 2, School: "Miami (OH)", Abbreviation: "MIA"},
+	}}})
+
+	_, err := r.Resolve("MIA")
+	assert.ErrorIs(t, err, ErrAmbiguous)
+
+	team, err := r.Resolve("Miami (OH)")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), team.GetId())
+}
+
+func TestResolve_AccentsAndAliases_ShouldResolve(t *testing.T) {
+	r := NewTeamResolver(
+		[]Season{{Season: 2025, Teams: []*cfbd.Team{
+			{Id: 23, School: "San José State", Abbreviation: "SJSU"},
+			{Id: 251, School: "Texas", Abbreviation: "TEX"},
+		}}},
+		WithAlias("Longhorns", 251),
+		WithMaxDistance(0),
+	)
+
+	team, err := r.Resolve("San Jose State")
+	require.NoError(t, err)
+	assert.Equal(t, int32(23), team.GetId())
+
+	team, err = r.Resolve("longhorns")
+	require.NoError(t, err)
+	assert.Equal(t, int32(251), team.GetId())
+
+	_, err = r.Resolve("Texass")
+	assert.ErrorIs(t, err, ErrUnresolved)
+}
+
+func TestResolveAll_ShouldSplitResolvedAndFailed(t *testing.T) {
+	r := NewTeamResolver([]Season{{Season: 2025, Teams: loadTeams(t)}})
+
+	resolved, failed := r.ResolveAll(2025,
+		[]string{"Texas", "Campbellsville", "UGA", "Campbellsville"})
+
+	assert.Len(t, resolved, 2)
+	assert.Equal(t, "Georgia", resolved["UGA"].GetSchool())
+	assert.Equal(t, []string{"Campbellsville"}, failed)
+}
+
+func TestSaveLoad_ShouldRoundTripOffline(t *testing.T) {
+	teams := loadTeams(t)
+	r := NewTeamResolver([]Season{
+		{Season: 2022, Teams: realigned(teams)},
+		{Season: 2024, Teams: teams},
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, r.Save(&buf))
+	loaded, err := Load(&buf)
+	require.NoError(t, err)
+
+	require.Len(t, loaded.Seasons(), 2)
+	team, err := loaded.ResolveIn(2023, "TEX")
+	require.NoError(t, err)
+	assert.Equal(t, "Big 12", team.GetConference())
+	assert.Equal(t, "America/Chicago",
+		loaded.Seasons()[1].Teams[0].GetLocation().GetTimezone())
+}
+
+type fakeSource struct {
+	teams map[int32][]*cfbd.Team
+	err   error
+}
+
+func (f *fakeSource) GetTeams(
+	_ context.Context, request cfbd.GetTeamsRequest,
+) ([]*cfbd.Team, error) {
+	return f.teams[request.Year], f.err
+}
+
+func TestBuild_ShouldFetchEverySeason(t *testing.T) {
+	teams := loadTeams(t)
+	src := &fakeSource{teams: map[int32][]*cfbd.Team{
+		2023: realigned(teams),
+		2024: teams,
+	}}
+
+	r, err := Build(context.Background(), src, []int32{2024, 2023})
+	require.NoError(t, err)
+
+	seasons := r.Seasons()
+	require.Len(t, seasons, 2)
+	assert.Equal(t, int32(2023), seasons[0].Season)
+}
+
+func TestBuild_SourceError_ShouldWrap(t *testing.T) {
+	boom := errors.New("boom")
+
+	_, err := Build(context.Background(), &fakeSource{err: boom},
+		[]int32{2024})
+
+	assert.ErrorIs(t, err, boom)
+}
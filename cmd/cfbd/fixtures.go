@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	defaultFixturesAddr = "127.0.0.1:8089"
+	defaultFixturesDir  = "cfbd/internal/test/responses"
+	shutdownTimeout     = 5 * time.Second
+)
+
+// fixtureHandler serves recorded API responses from a directory, standing in
+// for the CFBD API during development. A request path is mapped to a file by
+// trimming the leading slash, replacing the remaining slashes with
+// underscores and appending ".json", so GET /live/plays is answered with
+// live_plays.json. Query parameters are ignored.
+type fixtureHandler struct {
+	dir string
+}
+
+// ServeHTTP implements http.Handler.
+func (h fixtureHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := fixtureName(r.URL.Path)
+	if name == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	body, err := os.ReadFile(filepath.Join(h.dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		http.Error(w, "no fixture for "+r.URL.Path, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// fixtureName returns the fixture file name for the request path, or an
+// empty string if the path cannot name a fixture.
+func fixtureName(path string) string {
+	trimmed := strings.Trim(path, "/")
+	if trimmed == "" || strings.Contains(trimmed, "..") {
+		return ""
+	}
+
+	return strings.ReplaceAll(trimmed, "/", "_") + ".json"
+}
+
+func runFixtures(ctx context.Context, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("fixtures", flag.ContinueOnError)
+	fs.SetOutput(out)
+	addr := fs.String("addr", defaultFixturesAddr, "listen address")
+	dir := fs.String("dir", defaultFixturesDir, "directory of JSON fixtures")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(*dir); err != nil {
+		return fmt.Errorf("fixture directory unavailable; %w", err)
+	}
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           fixtureHandler{dir: *dir},
+		ReadHeaderTimeout: shutdownTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(
+			context.Background(), shutdownTimeout,
+		)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(out, "serving fixtures from %s on http://%s\n", *dir, *addr)
+	err := server.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
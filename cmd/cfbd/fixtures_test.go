@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testFixturesDir = "../../cfbd/internal/test/responses"
+
+func newFixtureServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(fixtureHandler{dir: testFixturesDir})
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFixtureName_ShouldMapPathToFile(t *testing.T) {
+	assert.Equal(t, "scoreboard.json", fixtureName("/scoreboard"))
+	assert.Equal(t, "live_plays.json", fixtureName("/live/plays"))
+	assert.Equal(t, "", fixtureName("/"))
+	assert.Equal(t, "", fixtureName("/../go.mod"))
+}
+
+func TestFixtureHandler_KnownEndpoint_ShouldServeClient(t *testing.T) {
+	server := newFixtureServer(t)
+	client, err := cfbd.New("fixtures", cfbd.WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	games, err := client.GetScoreboard(
+		context.Background(), cfbd.GetScoreboardRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, games, 1)
+	assert.Equal(t, int32(401762521), games[0].GetId())
+
+	live, err := client.GetLivePlays(
+		context.Background(), cfbd.GetLivePlaysRequest{GameID: 401778330},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int32(401778330), live.GetId())
+}
+
+func TestFixtureHandler_UnknownEndpoint_ShouldReturnNotFound(t *testing.T) {
+	server := newFixtureServer(t)
+
+	resp, err := http.Get(server.URL + "/not/recorded")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,65 @@
+// Command cfbd is a terminal companion for the College Football Data API.
+//
+// Usage:
+//
+//	cfbd tui [flags]        live game-day scoreboard dashboard
+//	cfbd fixtures [flags]   serve recorded API responses for development
+//
+// The API key is read from the CFBD_API_KEY environment variable.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+const apiKeyEnv = "CFBD_API_KEY"
+
+func main() {
+	ctx, stop := signal.NotifyContext(
+		context.Background(), os.Interrupt, syscall.SIGTERM,
+	)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "cfbd:", err)
+		os.Exit(1)
+	}
+}
+
+func run(
+	ctx context.Context, args []string, in io.Reader, out io.Writer,
+) error {
+	if len(args) == 0 {
+		printUsage(out)
+		return fmt.Errorf("a command is required")
+	}
+
+	switch args[0] {
+	case "tui":
+		return runTUI(ctx, args[1:], in, out)
+	case "fixtures":
+		return runFixtures(ctx, args[1:], out)
+	case "help", "-h", "--help":
+		printUsage(out)
+		return nil
+	default:
+		printUsage(out)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+func printUsage(out io.Writer) {
+	fmt.Fprint(out, `usage: cfbd <command> [flags]
+
+commands:
+  tui        live game-day scoreboard dashboard
+  fixtures   serve recorded API responses for development
+
+Run "cfbd <command> -h" for command flags.
+`)
+}
@@ -0,0 +1,42 @@
+package main
+
+import "time"
+
+// pacer decides how long the dashboard waits between refreshes so that the
+// API quota reported by GET /info lasts for the configured budget window.
+type pacer struct {
+	// interval is the preferred delay between refreshes.
+	interval time.Duration
+	// maxInterval caps the delay once the quota runs low.
+	maxInterval time.Duration
+	// window is how long the remaining quota should last.
+	window time.Duration
+	// reserve is the number of calls never spent by the dashboard.
+	reserve float64
+}
+
+// next returns the delay before the following refresh, given the number of
+// API calls a refresh costs and the remaining call quota. A negative
+// remaining value means the quota is unknown and the preferred interval is
+// used.
+func (p pacer) next(callsPerRefresh int, remaining float64) time.Duration {
+	if remaining < 0 || callsPerRefresh < 1 {
+		return p.interval
+	}
+
+	usable := remaining - p.reserve
+	if usable < float64(callsPerRefresh) {
+		return p.maxInterval
+	}
+
+	refreshes := usable / float64(callsPerRefresh)
+	delay := time.Duration(float64(p.window) / refreshes)
+	if delay < p.interval {
+		return p.interval
+	}
+	if delay > p.maxInterval {
+		return p.maxInterval
+	}
+
+	return delay
+}
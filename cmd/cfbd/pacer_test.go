@@ -0,0 +1,35 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testPacer() pacer {
+	return pacer{
+		interval:    30 * time.Second,
+		maxInterval: 10 * time.Minute,
+		window:      time.Hour,
+		reserve:     100,
+	}
+}
+
+func TestPacerNext_UnknownQuota_ShouldUseInterval(t *testing.T) {
+	assert.Equal(t, 30*time.Second, testPacer().next(2, -1))
+}
+
+func TestPacerNext_AmpleQuota_ShouldUseInterval(t *testing.T) {
+	assert.Equal(t, 30*time.Second, testPacer().next(2, 75000))
+}
+
+func TestPacerNext_LimitedQuota_ShouldStretchInterval(t *testing.T) {
+	// 60 usable calls at 2 calls per refresh is 30 refreshes in an hour.
+	assert.Equal(t, 2*time.Minute, testPacer().next(2, 160))
+}
+
+func TestPacerNext_ExhaustedQuota_ShouldUseMaxInterval(t *testing.T) {
+	assert.Equal(t, 10*time.Minute, testPacer().next(1, 100))
+	assert.Equal(t, 10*time.Minute, testPacer().next(1, 0))
+}
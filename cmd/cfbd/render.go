@@ -0,0 +1,293 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+const (
+	clearScreen     = "\033[H\033[2J"
+	driveChartWidth = 50
+	recentPlayLimit = 8
+	fieldYards      = 100
+)
+
+// isInProgress reports whether a scoreboard or live game status describes a
+// game that is currently being played, e.g. "in_progress" or "In Progress".
+func isInProgress(status string) bool {
+	normalized := strings.NewReplacer("_", "", " ", "").
+		Replace(strings.ToLower(status))
+	return normalized == "inprogress"
+}
+
+// filterGames returns the in-progress games, or every game when all is set.
+func filterGames(games []*cfbd.Scoreboard, all bool) []*cfbd.Scoreboard {
+	if all {
+		return games
+	}
+
+	filtered := make([]*cfbd.Scoreboard, 0, len(games))
+	for _, game := range games {
+		if isInProgress(game.GetStatus()) {
+			filtered = append(filtered, game)
+		}
+	}
+
+	return filtered
+}
+
+// renderScoreboard writes the list of games, numbered for selection.
+func renderScoreboard(w io.Writer, games []*cfbd.Scoreboard, all bool) {
+	title := "In-progress games"
+	if all {
+		title = "All games"
+	}
+	fmt.Fprintf(w, "%s (%d)\n\n", title, len(games))
+
+	if len(games) == 0 {
+		fmt.Fprintln(w, "  no games to show; press a to toggle all games")
+	}
+
+	for i, game := range games {
+		home, away := game.GetHomeTeam(), game.GetAwayTeam()
+		fmt.Fprintf(w, "%3d. %-28s %3s  @  %-28s %3s  %s\n",
+			i+1,
+			away.GetName(), teamPoints(away),
+			home.GetName(), teamPoints(home),
+			gameState(game),
+		)
+		if situation := game.GetSituation(); situation != "" {
+			fmt.Fprintf(w, "     %s\n", situation)
+		}
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "[number] open game  [a] toggle all  [r] refresh  [q] quit")
+}
+
+// renderGame writes the drill-down view of a single game.
+func renderGame(w io.Writer, game *cfbd.Scoreboard, live *cfbd.LiveGame) {
+	home, away := game.GetHomeTeam(), game.GetAwayTeam()
+	fmt.Fprintf(w, "%s %s  @  %s %s\n",
+		away.GetName(), teamPoints(away),
+		home.GetName(), teamPoints(home),
+	)
+	fmt.Fprintf(w, "%s", gameState(game))
+	if tv := game.GetTv(); tv != "" {
+		fmt.Fprintf(w, "  |  %s", tv)
+	}
+	fmt.Fprintln(w)
+	if situation := game.GetSituation(); situation != "" {
+		fmt.Fprintf(w, "%s\n", situation)
+	}
+	fmt.Fprintln(w)
+
+	renderWinProbability(w, game, live)
+
+	if live == nil {
+		fmt.Fprintln(w, "no live play-by-play available")
+	} else {
+		renderTeamEfficiency(w, live.GetTeams())
+		renderDriveChart(w, live.GetDrives())
+		renderRecentPlays(w, live.GetDrives())
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "[b] back  [r] refresh  [q] quit")
+}
+
+func renderWinProbability(
+	w io.Writer, game *cfbd.Scoreboard, live *cfbd.LiveGame,
+) {
+	home, away := game.GetHomeTeam(), game.GetAwayTeam()
+	homeWP, homeOK := winProbability(home)
+	awayWP, awayOK := winProbability(away)
+	if homeOK || awayOK {
+		fmt.Fprintln(w, "Win probability")
+		if awayOK {
+			fmt.Fprintf(w, "  %-28s %s\n",
+				away.GetName(), formatPercent(awayWP))
+		}
+		if homeOK {
+			fmt.Fprintf(w, "  %-28s %s\n",
+				home.GetName(), formatPercent(homeWP))
+		}
+		fmt.Fprintln(w)
+		return
+	}
+
+	var lines []string
+	for _, team := range live.GetTeams() {
+		if team.DeserveToWin == nil {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("  %-28s %s",
+			team.GetTeam(), formatPercent(team.GetDeserveToWin())))
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "Postgame win expectancy")
+	for _, line := range lines {
+		fmt.Fprintln(w, line)
+	}
+	fmt.Fprintln(w)
+}
+
+func renderTeamEfficiency(w io.Writer, teams []*cfbd.LiveGameTeam) {
+	if len(teams) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "%-16s %6s %8s %8s %8s %7s %7s %7s %7s\n",
+		"Team", "Plays", "EPA/pl", "EPA", "EPA/pa", "EPA/ru",
+		"Succ", "SD", "PD")
+	for _, team := range teams {
+		fmt.Fprintf(w, "%-16s %6d %8.3f %8.1f %8.3f %7.3f %7s %7s %7s\n",
+			truncate(team.GetTeam(), 16),
+			team.GetPlays(),
+			team.GetEpaPerPlay(),
+			team.GetTotalEpa(),
+			team.GetEpaPerPass(),
+			team.GetEpaPerRush(),
+			formatPercent(team.GetSuccessRate()),
+			formatPercent(team.GetStandardDownSuccessRate()),
+			formatPercent(team.GetPassingDownSuccessRate()),
+		)
+	}
+	fmt.Fprintln(w)
+}
+
+// renderDriveChart draws each drive as a bar across the field from the
+// offense's perspective, own goal line on the left.
+func renderDriveChart(w io.Writer, drives []*cfbd.LiveGameDrive) {
+	if len(drives) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "Drive chart")
+	for _, drive := range drives {
+		end := drive.GetStartYardsToGoal()
+		if drive.EndYardsToGoal != nil {
+			end = drive.GetEndYardsToGoal()
+		}
+		fmt.Fprintf(w, "  Q%d %5s %-12s |%s| %2d pl %3d yds %s\n",
+			drive.GetStartPeriod(),
+			drive.GetStartClock(),
+			truncate(drive.GetOffense(), 12),
+			driveBar(drive.GetStartYardsToGoal(), end),
+			drive.GetPlayCount(),
+			drive.GetYards(),
+			drive.GetResult(),
+		)
+	}
+	fmt.Fprintln(w)
+}
+
+// driveBar renders the span between two yards-to-goal positions.
+func driveBar(startYardsToGoal, endYardsToGoal int32) string {
+	position := func(yardsToGoal int32) int {
+		clamped := min(max(yardsToGoal, 0), fieldYards)
+		cell := (fieldYards - int(clamped)) * driveChartWidth / fieldYards
+		return min(cell, driveChartWidth-1)
+	}
+
+	start, end := position(startYardsToGoal), position(endYardsToGoal)
+	cells := []rune(strings.Repeat(" ", driveChartWidth))
+	lo, hi := min(start, end), max(start, end)
+	for i := lo; i <= hi; i++ {
+		cells[i] = '='
+	}
+	if end >= start {
+		cells[end] = '>'
+	} else {
+		cells[end] = '<'
+	}
+
+	return string(cells)
+}
+
+func renderRecentPlays(w io.Writer, drives []*cfbd.LiveGameDrive) {
+	var plays []*cfbd.LiveGamePlay
+	for _, drive := range drives {
+		plays = append(plays, drive.GetPlays()...)
+	}
+	if len(plays) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "Recent plays")
+	for i := len(plays) - 1; i >= 0 && i >= len(plays)-recentPlayLimit; i-- {
+		play := plays[i]
+		epa := "     "
+		if play.Epa != nil {
+			epa = fmt.Sprintf("%+5.2f", play.GetEpa())
+		}
+		fmt.Fprintf(w, "  Q%d %5s %-12s %-10s %s  %s\n",
+			play.GetPeriod(),
+			play.GetClock(),
+			truncate(play.GetTeam(), 12),
+			downAndDistance(play.GetDown(), play.GetDistance()),
+			epa,
+			truncate(play.GetPlayText(), 80),
+		)
+	}
+}
+
+func gameState(game *cfbd.Scoreboard) string {
+	if isInProgress(game.GetStatus()) {
+		return fmt.Sprintf("Q%d %s", game.GetPeriod(), game.GetClock())
+	}
+	if game.GetStatus() == "scheduled" && game.GetStartDate() != nil {
+		return game.GetStartDate().AsTime().Local().Format("Mon 3:04 PM")
+	}
+
+	return game.GetStatus()
+}
+
+func downAndDistance(down, distance int32) string {
+	suffix := map[int32]string{1: "st", 2: "nd", 3: "rd", 4: "th"}
+	if _, ok := suffix[down]; !ok {
+		return ""
+	}
+
+	return fmt.Sprintf("%d%s & %d", down, suffix[down], distance)
+}
+
+func teamPoints(team *cfbd.ScoreboardTeam) string {
+	if team == nil || team.Points == nil {
+		return "-"
+	}
+
+	return fmt.Sprintf("%d", team.GetPoints())
+}
+
+func winProbability(team *cfbd.ScoreboardTeam) (float64, bool) {
+	if team == nil || team.WinProbability == nil {
+		return 0, false
+	}
+
+	return team.GetWinProbability(), true
+}
+
+func formatPercent(v float64) string {
+	return fmt.Sprintf("%.1f%%", v*100)
+}
+
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+
+	return string(runes[:n-1]) + "…"
+}
+
+func formatClock(t time.Time) string {
+	return t.Local().Format("15:04:05")
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func loadLiveGame(t *testing.T) *cfbd.LiveGame {
+	b, err := os.ReadFile(filepath.Join(testFixturesDir, "live_plays.json"))
+	require.NoError(t, err)
+
+	var game cfbd.LiveGame
+	opts := protojson.UnmarshalOptions{DiscardUnknown: true}
+	require.NoError(t, opts.Unmarshal(b, &game))
+	return &game
+}
+
+func TestIsInProgress_ShouldNormalizeStatus(t *testing.T) {
+	assert.True(t, isInProgress("in_progress"))
+	assert.True(t, isInProgress("In Progress"))
+	assert.False(t, isInProgress("completed"))
+	assert.False(t, isInProgress("scheduled"))
+}
+
+func TestFilterGames_ShouldKeepInProgressGames(t *testing.T) {
+	games := []*cfbd.Scoreboard{
+		{Id: 1, Status: "completed"},
+		{Id: 2, Status: "in_progress"},
+	}
+
+	assert.Len(t, filterGames(games, true), 2)
+	filtered := filterGames(games, false)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, int32(2), filtered[0].GetId())
+}
+
+func TestRenderScoreboard_ShouldShowTeamsAndPoints(t *testing.T) {
+	games := []*cfbd.Scoreboard{{
+		Id:       1,
+		Status:   "in_progress",
+		HomeTeam: &cfbd.ScoreboardTeam{Name: "Navy", Points: proto.Int32(17)},
+		AwayTeam: &cfbd.ScoreboardTeam{Name: "Army"},
+	}}
+	var out strings.Builder
+
+	renderScoreboard(&out, games, false)
+
+	text := out.String()
+	assert.Contains(t, text, "Army")
+	assert.Contains(t, text, " 17 ")
+	assert.Contains(t, text, "  -  ")
+}
+
+func TestRenderGame_ScoreboardWinProbability_ShouldPreferScoreboard(
+	t *testing.T,
+) {
+	game := &cfbd.Scoreboard{
+		HomeTeam: &cfbd.ScoreboardTeam{
+			Name: "Navy", WinProbability: proto.Float64(0.625),
+		},
+		AwayTeam: &cfbd.ScoreboardTeam{
+			Name: "Army", WinProbability: proto.Float64(0.375),
+		},
+	}
+	var out strings.Builder
+
+	renderGame(&out, game, loadLiveGame(t))
+
+	text := out.String()
+	assert.Contains(t, text, "Win probability")
+	assert.Contains(t, text, "62.5%")
+	assert.NotContains(t, text, "Postgame win expectancy")
+}
+
+func TestDriveBar_ShouldSpanFieldPositions(t *testing.T) {
+	bar := driveBar(75, 25)
+	assert.Len(t, []rune(bar), driveChartWidth)
+	assert.Equal(t, 12, strings.Index(bar, "="))
+	assert.Equal(t, 37, strings.Index(bar, ">"))
+
+	backwards := driveBar(30, 40)
+	assert.Contains(t, backwards, "<")
+}
+
+func TestRenderGame_LiveFixture_ShouldShowDrivesAndEfficiency(t *testing.T) {
+	live := loadLiveGame(t)
+	var out strings.Builder
+
+	renderGame(&out, &cfbd.Scoreboard{Id: live.GetId()}, live)
+
+	text := out.String()
+	assert.Contains(t, text, "Postgame win expectancy")
+	assert.Contains(t, text, "Drive chart")
+	assert.Contains(t, text, "Recent plays")
+	assert.Contains(t, text, "Field Goal")
+	assert.Contains(t, text, "42.9%")
+}
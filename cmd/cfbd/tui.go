@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/clintrovert/cfbd-go/cfbd"
+)
+
+const (
+	defaultRefreshInterval = 30 * time.Second
+	defaultMaxInterval     = 10 * time.Minute
+	defaultBudgetWindow    = 6 * time.Hour
+	defaultQuotaReserve    = 100
+	// quotaCheckEvery is how many refreshes pass between GET /info calls;
+	// in between, the remaining quota is tracked locally.
+	quotaCheckEvery = 20
+)
+
+// dashboard holds the state of the game-day TUI.
+type dashboard struct {
+	client  *cfbd.Client
+	request cfbd.GetScoreboardRequest
+	pacer   pacer
+	out     io.Writer
+
+	showAll   bool
+	games     []*cfbd.Scoreboard
+	selected  int32
+	live      *cfbd.LiveGame
+	remaining float64
+	refreshes int
+	updated   time.Time
+	nextAt    time.Time
+	err       error
+}
+
+func runTUI(
+	ctx context.Context, args []string, in io.Reader, out io.Writer,
+) error {
+	fs := flag.NewFlagSet("tui", flag.ContinueOnError)
+	fs.SetOutput(out)
+	baseURL := fs.String("base-url", "",
+		"API base URL, e.g. http://127.0.0.1:8089 for the fixtures server")
+	classification := fs.String("classification", "fbs",
+		"scoreboard classification filter")
+	conference := fs.String("conference", "", "scoreboard conference filter")
+	all := fs.Bool("all", false, "list every game, not only in-progress games")
+	interval := fs.Duration("interval", defaultRefreshInterval,
+		"preferred refresh interval")
+	maxInterval := fs.Duration("max-interval", defaultMaxInterval,
+		"longest refresh interval when quota runs low")
+	window := fs.Duration("budget-window", defaultBudgetWindow,
+		"how long the remaining API quota should last")
+	reserve := fs.Int("reserve", defaultQuotaReserve,
+		"API calls to leave unspent")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	apiKey := os.Getenv(apiKeyEnv)
+	if apiKey == "" && *baseURL != "" {
+		// The fixtures server does not authenticate.
+		apiKey = "fixtures"
+	}
+
+	var opts []cfbd.Option
+	if *baseURL != "" {
+		opts = append(opts, cfbd.WithBaseURL(*baseURL))
+	}
+	client, err := cfbd.New(apiKey, opts...)
+	if err != nil {
+		return fmt.Errorf("could not create client; %w", err)
+	}
+
+	d := &dashboard{
+		client: client,
+		request: cfbd.GetScoreboardRequest{
+			Classification: *classification,
+			Conference:     *conference,
+		},
+		pacer: pacer{
+			interval:    *interval,
+			maxInterval: *maxInterval,
+			window:      *window,
+			reserve:     float64(*reserve),
+		},
+		out:       out,
+		showAll:   *all,
+		remaining: -1,
+	}
+
+	return d.run(ctx, readCommands(in))
+}
+
+// readCommands forwards trimmed input lines until the reader is exhausted.
+func readCommands(in io.Reader) <-chan string {
+	commands := make(chan string)
+	go func() {
+		defer close(commands)
+		scanner := bufio.NewScanner(in)
+		for scanner.Scan() {
+			commands <- strings.TrimSpace(scanner.Text())
+		}
+	}()
+
+	return commands
+}
+
+func (d *dashboard) run(ctx context.Context, commands <-chan string) error {
+	d.refresh(ctx)
+	for {
+		d.render()
+
+		timer := time.NewTimer(time.Until(d.nextAt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+			d.refresh(ctx)
+		case command, ok := <-commands:
+			timer.Stop()
+			if !ok || !d.handle(ctx, command) {
+				return nil
+			}
+		}
+	}
+}
+
+// handle applies a user command and reports whether the dashboard should
+// keep running.
+func (d *dashboard) handle(ctx context.Context, command string) bool {
+	switch strings.ToLower(command) {
+	case "q", "quit":
+		return false
+	case "r":
+		d.refresh(ctx)
+	case "a":
+		d.showAll = !d.showAll
+	case "b":
+		d.selected = 0
+		d.live = nil
+	default:
+		n, err := strconv.Atoi(command)
+		games := filterGames(d.games, d.showAll)
+		if d.selected != 0 || err != nil || n < 1 || n > len(games) {
+			return true
+		}
+		d.selected = games[n-1].GetId()
+		d.refresh(ctx)
+	}
+
+	return true
+}
+
+// refresh reloads the scoreboard and, when a game is open, its live plays,
+// then schedules the next refresh against the remaining quota.
+func (d *dashboard) refresh(ctx context.Context) {
+	calls := 1
+	if d.refreshes%quotaCheckEvery == 0 {
+		if info, err := d.client.GetInfo(ctx); err == nil {
+			d.remaining = info.GetRemainingCalls()
+		}
+	}
+	d.refreshes++
+
+	games, err := d.client.GetScoreboard(ctx, d.request)
+	d.err = err
+	if err == nil {
+		d.games = games
+	}
+
+	if d.selected != 0 {
+		calls++
+		live, err := d.client.GetLivePlays(
+			ctx, cfbd.GetLivePlaysRequest{GameID: d.selected},
+		)
+		if err != nil {
+			d.err = err
+		} else {
+			d.live = live
+		}
+	}
+
+	if d.remaining >= 0 {
+		d.remaining = max(d.remaining-float64(calls), 0)
+	}
+	d.updated = time.Now()
+	d.nextAt = d.updated.Add(d.pacer.next(calls, d.remaining))
+}
+
+func (d *dashboard) render() {
+	fmt.Fprint(d.out, clearScreen)
+	fmt.Fprintf(d.out, "cfbd live  |  updated %s  |  next %s",
+		formatClock(d.updated), formatClock(d.nextAt))
+	if d.remaining >= 0 {
+		fmt.Fprintf(d.out, "  |  %.0f calls left", d.remaining)
+	}
+	fmt.Fprintln(d.out)
+	if d.err != nil {
+		fmt.Fprintf(d.out, "error: %v\n", d.err)
+	}
+	fmt.Fprintln(d.out)
+
+	if d.selected == 0 {
+		renderScoreboard(d.out, filterGames(d.games, d.showAll), d.showAll)
+		return
+	}
+
+	for _, game := range d.games {
+		if game.GetId() == d.selected {
+			renderGame(d.out, game, d.live)
+			return
+		}
+	}
+	fmt.Fprintln(d.out, "game is no longer on the scoreboard; press b")
+}
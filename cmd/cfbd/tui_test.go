@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunTUI_FixtureServer_ShouldDrillIntoGame(t *testing.T) {
+	server := newFixtureServer(t)
+	var out strings.Builder
+
+	err := run(
+		context.Background(),
+		[]string{"tui", "-base-url", server.URL, "-all"},
+		strings.NewReader("1\nb\nq\n"),
+		&out,
+	)
+
+	require.NoError(t, err)
+	text := out.String()
+	assert.Contains(t, text, "All games (1)")
+	assert.Contains(t, text, "Army Black Knights")
+	assert.Contains(t, text, "Drive chart")
+	assert.Contains(t, text, "calls left")
+}
+
+func TestRun_UnknownCommand_ShouldFail(t *testing.T) {
+	var out strings.Builder
+	err := run(context.Background(), []string{"nope"}, nil, &out)
+	assert.Error(t, err)
+	assert.Contains(t, out.String(), "usage: cfbd")
+}